@@ -31,15 +31,19 @@ func main() {
 	log.Printf("Configuration loaded. Running in production=%s, port=%s", cfg.Production, cfg.Port)
 
 	// ------- Initialize Server -------
-	_, httpServer, err := server.NewServer(cfg)
+	appServer, httpServer, err := server.NewServer(cfg)
 	if err != nil {
 		panic(fmt.Sprintf("server initialization error: %v", err))
 	}
 
 	// ------- Context & Background tasks -------
-	_, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
+	// Write queued leaderboard updates, in this process as it may hold the
+	// leaderboards in memory
+	go appServer.FlushLeaderboards(ctx)
+
 	// Start report notifier
 	// appServer.StartReportNotifier(ctx)
 
@@ -0,0 +1,47 @@
+// Command backfill-players creates the missing player row, with the
+// configured starter kit, for every account that does not have one yet.
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/0xdbb/eggsplore/internal/config"
+	db "github.com/0xdbb/eggsplore/internal/database/sqlc"
+)
+
+func main() {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+
+	ctx := context.Background()
+	store := db.NewService(cfg.DbUrl)
+
+	accounts, err := store.ListAccountsWithoutPlayer(ctx)
+	if err != nil {
+		log.Fatalf("Failed to list accounts without player: %v", err)
+	}
+
+	log.Printf("Found %d accounts without a player", len(accounts))
+
+	kit := db.StarterKit{
+		Coins: cfg.StartingCoins,
+		Tools: cfg.StarterTools,
+	}
+
+	var created, failed int
+	for _, account := range accounts {
+		player, err := store.ProvisionPlayerTx(ctx, account.ID, kit)
+		if err != nil {
+			log.Printf("⚠️ Failed to provision player for account %s: %v", account.ID, err)
+			failed++
+			continue
+		}
+		log.Printf("Created player %s for account %s", player.ID, account.ID)
+		created++
+	}
+
+	log.Printf("Backfill complete: %d created, %d failed", created, failed)
+}
@@ -0,0 +1,46 @@
+// Command reconcile-ledger recomputes every player's coins and XP from the
+// ledger and reports the players whose stored balances disagree. With -apply
+// the stored balances are reset to the ledger's and levels recomputed.
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"github.com/0xdbb/eggsplore/internal/config"
+	db "github.com/0xdbb/eggsplore/internal/database/sqlc"
+)
+
+func main() {
+	apply := flag.Bool("apply", false, "reset mismatching balances to the ledger's")
+	flag.Parse()
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+
+	ctx := context.Background()
+	store := db.NewService(cfg.DbUrl)
+	store.SetXPCurve(cfg.XPCurve())
+
+	result, err := store.ReconcileLedgerTx(ctx, *apply)
+	if err != nil {
+		log.Fatalf("Failed to reconcile ledger: %v", err)
+	}
+
+	for _, t := range result.Unbalanced {
+		log.Printf("⚠️ Ledger transaction %s does not balance: %s legs sum to %d", t.TransactionID, t.Currency, t.Total)
+	}
+
+	for _, m := range result.Mismatches {
+		log.Printf("Player %s: coins %d (ledger %d), xp %d (ledger %d)", m.PlayerID, m.Coins, m.LedgerCoins, m.Xp, m.LedgerXp)
+	}
+
+	if *apply {
+		log.Printf("Reconcile complete: %d players reset, %d unbalanced transactions", len(result.Mismatches), len(result.Unbalanced))
+	} else {
+		log.Printf("Reconcile complete: %d players mismatch, %d unbalanced transactions (run with -apply to fix balances)", len(result.Mismatches), len(result.Unbalanced))
+	}
+}
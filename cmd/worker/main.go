@@ -0,0 +1,50 @@
+// Command worker runs the game's background jobs: the spawner that keeps the
+// world stocked with server-owned eggs and expires the uncollected ones, and
+// the pruner that deletes old player locations.
+package main
+
+import (
+	"context"
+	"log"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	"github.com/0xdbb/eggsplore/internal/config"
+	db "github.com/0xdbb/eggsplore/internal/database/sqlc"
+	"github.com/0xdbb/eggsplore/internal/pruner"
+	"github.com/0xdbb/eggsplore/internal/spawner"
+)
+
+func main() {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+
+	// Stop the jobs on SIGINT/SIGTERM, letting the current tick finish
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	store := db.NewService(cfg.DbUrl)
+	store.SetXPCurve(cfg.XPCurve())
+
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		log.Printf("Spawner running every %s", cfg.SpawnInterval)
+		spawner.New(store, cfg.SpawnRules()).Run(ctx, cfg.SpawnInterval)
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		log.Printf("Pruner running every %s, keeping locations for %s", cfg.LocationPruneInterval, cfg.LocationRetention)
+		pruner.New(store, cfg.LocationRetention).Run(ctx, cfg.LocationPruneInterval)
+	}()
+
+	wg.Wait()
+	log.Println("Worker stopped.")
+}
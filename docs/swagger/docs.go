@@ -15,9 +15,92 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/auth/login": {
+        "/admin/incidents": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List reported locations the anti-cheat flagged, newest first, with the signals raised and what happened to the action",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "List Location Incidents",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Only incidents of this player",
+                        "name": "player_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only reviewed (true) or open (false) incidents",
+                        "name": "reviewed",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 50, max 200)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/internal_server.LocationIncidentResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/incidents/{id}/review": {
             "post": {
-                "description": "Login account with email and password",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Confirm or dismiss a flagged location. Dismissing lifts the hold it put on the player's actions.",
                 "consumes": [
                     "application/json"
                 ],
@@ -25,17 +108,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "admin"
                 ],
-                "summary": "Login Account",
+                "summary": "Review Location Incident",
                 "parameters": [
                     {
-                        "description": "Account Login Request",
+                        "type": "integer",
+                        "description": "Incident ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Review",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_server.AccountLoginRequest"
+                            "$ref": "#/definitions/internal_server.ReviewLocationIncidentRequest"
                         }
                     }
                 ],
@@ -43,7 +133,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/internal_server.Message"
+                            "$ref": "#/definitions/internal_server.UserMessage"
                         }
                     },
                     "400": {
@@ -52,6 +142,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/internal_server.ErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -67,21 +169,29 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/logout": {
-            "post": {
-                "description": "Logout account by deleting session and clearing cookies",
+        "/admin/spawn-points": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List all spawn points, including disabled ones",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "admin"
                 ],
-                "summary": "Logout Account",
+                "summary": "List Spawn Points",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/internal_server.Message"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/internal_server.SpawnPointResponse"
+                            }
                         }
                     },
                     "401": {
@@ -90,6 +200,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/internal_server.ErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -97,11 +213,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/auth/register": {
+            },
             "post": {
-                "description": "Register account with email and password",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a place the world spawner keeps up to max_active eggs around, within radius_meters. Spawns inside exclusion zones are still skipped.",
                 "consumes": [
                     "application/json"
                 ],
@@ -109,25 +228,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "admin"
                 ],
-                "summary": "Register Account",
+                "summary": "Create Spawn Point",
                 "parameters": [
                     {
-                        "description": "Account Login Request",
+                        "description": "Spawn point",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_server.RegisterAccountRequest"
+                            "$ref": "#/definitions/internal_server.CreateSpawnPointRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/internal_server.Message"
+                            "$ref": "#/definitions/internal_server.SpawnPointResponse"
                         }
                     },
                     "400": {
@@ -136,8 +255,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/internal_server.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/internal_server.ErrorResponse"
                         }
@@ -151,56 +276,63 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/renew": {
-            "post": {
-                "description": "Generates a new access token using a valid refresh token",
-                "consumes": [
-                    "application/json"
+        "/admin/spawn-points/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Delete a spawn point. Eggs it already spawned stay until they are collected or expire.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "admin"
                 ],
-                "summary": "Renew Access Token",
+                "summary": "Delete Spawn Point",
                 "parameters": [
                     {
-                        "description": "Refresh Token Request",
-                        "name": "body",
-                        "in": "body",
-                        "schema": {
-                            "$ref": "#/definitions/internal_server.renewAccessTokenRequest"
-                        }
+                        "type": "integer",
+                        "description": "Spawn point ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/internal_server.renewAccessTokenResponse"
+                            "$ref": "#/definitions/internal_server.UserMessage"
                         }
                     },
                     "400": {
-                        "description": "Invalid request",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/internal_server.ErrorResponse"
                         }
                     },
                     "401": {
-                        "description": "Unauthorized or Invalid token",
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/internal_server.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Session not found",
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/internal_server.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/internal_server.ErrorResponse"
                         }
@@ -208,37 +340,39 @@ const docTemplate = `{
                 }
             }
         },
-        "/game/eggs": {
+        "/admin/zones": {
             "get": {
-                "description": "Get all eggs belonging to a player",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List all no-drop zones, newest first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "game"
-                ],
-                "summary": "Get Player Eggs",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Player ID",
-                        "name": "player_id",
-                        "in": "query",
-                        "required": true
-                    }
+                    "admin"
                 ],
+                "summary": "List Exclusion Zones",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/internal_server.GetEggsByPlayerResponse"
+                                "$ref": "#/definitions/internal_server.ExclusionZoneResponse"
                             }
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/internal_server.ErrorResponse"
                         }
@@ -252,7 +386,12 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Player drops an egg (adds to inventory + eggs table)",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a zone where eggs cannot be dropped or spawned. Give exactly one of bbox, center with half_size_meters, or a GeoJSON Polygon/MultiPolygon geometry.",
                 "consumes": [
                     "application/json"
                 ],
@@ -260,25 +399,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "game"
+                    "admin"
                 ],
-                "summary": "Drop Egg",
+                "summary": "Create Exclusion Zone",
                 "parameters": [
                     {
-                        "description": "Drop Egg Request",
+                        "description": "Zone",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_server.DropEggRequest"
+                            "$ref": "#/definitions/internal_server.CreateExclusionZoneRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/internal_server.DropEggResponse"
+                            "$ref": "#/definitions/internal_server.ExclusionZoneResponse"
                         }
                     },
                     "400": {
@@ -287,6 +426,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/internal_server.ErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -296,32 +447,42 @@ const docTemplate = `{
                 }
             }
         },
-        "/game/inventory": {
-            "get": {
-                "description": "Get all inventory items (tools, eggs, boosts) belonging to a player",
+        "/admin/zones/import": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Import zones from a GeoJSON FeatureCollection, Feature or bare Polygon/MultiPolygon. Feature properties \"name\" and \"description\" are used when present. Either every zone is created or none.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "game"
+                    "admin"
                 ],
-                "summary": "Get Player Inventory",
+                "summary": "Import Exclusion Zones",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Player ID",
-                        "name": "player_id",
-                        "in": "query",
-                        "required": true
+                        "description": "GeoJSON",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/internal_server.Inventory"
+                                "$ref": "#/definitions/internal_server.ExclusionZoneResponse"
                             }
                         }
                     },
@@ -331,6 +492,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/internal_server.ErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -340,22 +513,27 @@ const docTemplate = `{
                 }
             }
         },
-        "/game/player": {
-            "get": {
-                "description": "Get player stats by account id",
+        "/admin/zones/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a no-drop zone",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "game"
+                    "admin"
                 ],
-                "summary": "Get Player Stats",
+                "summary": "Delete Exclusion Zone",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Account ID",
-                        "name": "account_id",
-                        "in": "query",
+                        "description": "Zone ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -363,7 +541,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/internal_server.Players"
+                            "$ref": "#/definitions/internal_server.UserMessage"
                         }
                     },
                     "400": {
@@ -372,6 +550,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/internal_server.ErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -381,37 +577,290 @@ const docTemplate = `{
                 }
             }
         },
-        "/game/tools": {
-            "get": {
-                "description": "Get all tools belonging to a player",
-                "produces": [
+        "/auth/login": {
+            "post": {
+                "description": "Login account with email and password",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "game"
+                    "auth"
                 ],
-                "summary": "Get Player Tools",
+                "summary": "Login Account",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Player ID",
-                        "name": "player_id",
-                        "in": "query",
-                        "required": true
+                        "description": "Account Login Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.AccountLoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.Message"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/logout": {
+            "post": {
+                "description": "Logout account by deleting session and clearing cookies",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Logout Account",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.Message"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/register": {
+            "post": {
+                "description": "Register account with email and password and provision its player with the starter kit",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Register Account",
+                "parameters": [
+                    {
+                        "description": "Account Login Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.RegisterAccountRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.Message"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/renew": {
+            "post": {
+                "description": "Generates a new access token using a valid refresh token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Renew Access Token",
+                "parameters": [
+                    {
+                        "description": "Refresh Token Request",
+                        "name": "body",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.renewAccessTokenRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.renewAccessTokenResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized or Invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Session not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
                     }
+                }
+            }
+        },
+        "/game/achievements": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List every achievement with the caller's progress, unlock and claim times",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
                 ],
+                "summary": "List Achievements",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/internal_server.GetToolsByPlayerResponse"
+                                "$ref": "#/definitions/internal_server.AchievementResponse"
                             }
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/achievements/{code}/claim": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Claim the coin and XP reward of an unlocked achievement. Each reward can be claimed once.\nRejections carry an error_code: ACHIEVEMENT_NOT_FOUND, ACHIEVEMENT_NOT_UNLOCKED or ACHIEVEMENT_ALREADY_CLAIMED.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Claim Achievement",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Achievement code",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ClaimAchievementResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/internal_server.ErrorResponse"
                         }
@@ -424,242 +873,4829 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "internal_server.AccountLoginRequest": {
+        },
+        "/game/boosts": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the boosts a player owns and the ones currently active",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Get Player Boosts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Player ID (defaults to the caller, must be the caller's)",
+                        "name": "player_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.PlayerBoostsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/boosts/{id}/activate": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Use one boost from a stack in the caller's inventory. It applies to the caller's game actions until it expires. Active boosts of the same kind stack.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Activate Boost",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Boost inventory ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ActivateBoostResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/creatures": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all creatures hatched by a player",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Get Player Creatures",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Player ID (defaults to the caller, must be the caller's)",
+                        "name": "player_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/internal_server.CreatureResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/dex": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get every species with whether the player has discovered it and how many they have caught",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Get Player Dex",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Player ID (defaults to the caller, must be the caller's)",
+                        "name": "player_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.DexResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/egg-types": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the egg types that can currently be dropped",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "List Egg Types",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/internal_server.EggTypeResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/eggs": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all eggs belonging to a player with their lifecycle state and the time left until the next state",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Get Player Eggs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Player ID (defaults to the caller, must be the caller's)",
+                        "name": "player_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/internal_server.GetEggsByPlayerResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Player drops an egg (adds to inventory + eggs table). The egg type's coin cost is charged.\nRejections carry an error_code: DROP_LEVEL_TOO_LOW, DROP_EGG_TYPE_LOCKED, DROP_INSUFFICIENT_COINS, DROP_COOLDOWN, DROP_TOO_MANY_ACTIVE_EGGS, DROP_TOO_CLOSE_TO_EGG or DROP_EXCLUDED_AREA.\nThe drop location is checked against the caller's recent locations; one that looks faked is rejected (LOCATION_REJECTED) or holds the caller's location based actions for a while (LOCATION_ON_HOLD).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Drop Egg",
+                "parameters": [
+                    {
+                        "description": "Drop Egg Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.DropEggRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.DropEggResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "402": {
+                        "description": "Payment Required",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/eggs/clusters": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the active eggs in a viewport for a map at a zoom level, split into map tiles (slippy map numbering). Below zoom 17 each tile holds clusters of eggs, counted per egg type; from zoom 17 on it lists the eggs one by one. Other players' eggs are counted and placed at the center of the roughly 550m grid cell they lie in; only the caller's own eggs are listed at their exact position. Tiles are cached for a short while, so eggs may show up or go away a little late.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Get Egg Clusters",
+                "parameters": [
+                    {
+                        "type": "number",
+                        "description": "Western longitude",
+                        "name": "xmin",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Southern latitude",
+                        "name": "ymin",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Eastern longitude",
+                        "name": "xmax",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Northern latitude",
+                        "name": "ymax",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Map zoom level, 0 to 22",
+                        "name": "zoom",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.EggClustersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/eggs/nearby": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get unhatched eggs around a position, sorted by distance. Searches within a radius (meters) or, when xmin/ymin/xmax/ymax are all given, within that bounding box, which must lie within the radius the caller could search. Only the caller's own eggs come with their owner and exact position; other players' eggs are searched, placed and measured at the center of the roughly 550m grid cell they lie in.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Get Nearby Eggs",
+                "parameters": [
+                    {
+                        "type": "number",
+                        "description": "Latitude of the caller",
+                        "name": "lat",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Longitude of the caller",
+                        "name": "lon",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Search radius in meters (default 500, max 5000, extended by discovery boosts and an equipped radar)",
+                        "name": "radius",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Bounding box min longitude",
+                        "name": "xmin",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Bounding box min latitude",
+                        "name": "ymin",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Bounding box max longitude",
+                        "name": "xmax",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Bounding box max latitude",
+                        "name": "ymax",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 50, max 200)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/internal_server.NearbyEggResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/eggs/tiles/{z}/{x}/{y}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the active eggs in one map tile (slippy map numbering): clusters counted per egg type below zoom 17, the eggs one by one from it on. Other players' eggs are placed at the center of the roughly 550m grid cell they lie in. Each tile has its own URL, so clients can cache tiles as they pan.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Get Egg Tile",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Zoom level, 0 to 22",
+                        "name": "z",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Tile column",
+                        "name": "x",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Tile row",
+                        "name": "y",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.EggTileResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/eggs/{id}/collect": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Pick up an egg another player dropped. The caller's last reported location must be within the configured hatch distance of the egg. The egg moves to the caller's inventory and keeps incubating there; the caller earns half of the egg type's hatch reward and the dropper a quarter. Shielded eggs cannot be collected.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Collect Egg",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Egg inventory ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.CollectEggResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/eggs/{id}/hatch": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Hatch one of the caller's READY eggs. Unless the egg was collected, the caller's last reported location must be within the configured distance of it, extended by an equipped shovel. A creature rolled from the egg type's species joins the caller's collection.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Hatch Egg",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Egg inventory ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.HatchEggResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/eggs/{id}/hint": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a hint towards an egg still in the world: how close the caller's last reported location is (HOT, WARM or COLD), the rough direction and a circle the egg lies within. The circle is offset from the egg at random and is the same for every player in every band. Hints are rate limited per player (HINT_RATE_LIMITED).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Get Egg Hint",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Egg inventory ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.EggHintResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/inventory": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all inventory items (tools, eggs, boosts) belonging to a player",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Get Player Inventory",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Player ID (defaults to the caller, must be the caller's)",
+                        "name": "player_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/internal_server.Inventory"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/leaderboards/{board}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a page of a leaderboard, from the top. Boards rank players by xp, eggs_hatched or distance (meters travelled while reporting locations), over all time (global), this week since Monday 00:00 UTC (weekly) or all time within a region (region). Scores follow game actions within a few seconds.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Get Leaderboard",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board (xp, eggs_hatched or distance)",
+                        "name": "board",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Scope (global, weekly or region, default global)",
+                        "name": "scope",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Region of a region board, e.g., Europe (defaults to the caller's)",
+                        "name": "region",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 50, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.LeaderboardResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/leaderboards/{board}/me": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the caller's rank on a leaderboard and the players ranked just above and below them",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Get My Leaderboard Rank",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board (xp, eggs_hatched or distance)",
+                        "name": "board",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Scope (global, weekly or region, default global)",
+                        "name": "scope",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Region of a region board, e.g., Europe (defaults to the caller's)",
+                        "name": "region",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Players to include on either side (default 5, max 25)",
+                        "name": "neighbours",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.LeaderboardAroundResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/ledger": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the history of a player's coin and XP changes, newest first, with the balance after each change",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Get Player Ledger",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Player ID (defaults to the caller, must be the caller's)",
+                        "name": "player_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only entries of this currency (COIN or XP)",
+                        "name": "currency",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 50, max 200)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/internal_server.LedgerEntryResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/location": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Report the caller's current position. Clients send it periodically while the app is open; hatching and collecting eggs check the distance from the last reported position. Distances between positions reported in a row count as travelled on the leaderboards. Positions that look faked compared to the caller's recent ones are rejected (LOCATION_REJECTED) or put the caller's location based actions on hold.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Report Location",
+                "parameters": [
+                    {
+                        "description": "Current position",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ReportLocationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.LocationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/me": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get player stats by account id, with the XP into the current level, the XP needed for the next one, the login streak and the next daily reward",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Get Player Stats",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Account ID (defaults to the caller, must be the caller's)",
+                        "name": "account_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.PlayerStatsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/me/achievements": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List every achievement with the caller's progress, unlock and claim times",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "List Achievements",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/internal_server.AchievementResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/me/achievements/{code}/claim": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Claim the coin and XP reward of an unlocked achievement. Each reward can be claimed once.\nRejections carry an error_code: ACHIEVEMENT_NOT_FOUND, ACHIEVEMENT_NOT_UNLOCKED or ACHIEVEMENT_ALREADY_CLAIMED.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Claim Achievement",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Achievement code",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ClaimAchievementResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/me/boosts": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the boosts a player owns and the ones currently active",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Get Player Boosts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Player ID (defaults to the caller, must be the caller's)",
+                        "name": "player_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.PlayerBoostsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/me/boosts/{id}/activate": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Use one boost from a stack in the caller's inventory. It applies to the caller's game actions until it expires. Active boosts of the same kind stack.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Activate Boost",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Boost inventory ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ActivateBoostResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/me/creatures": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all creatures hatched by a player",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Get Player Creatures",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Player ID (defaults to the caller, must be the caller's)",
+                        "name": "player_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/internal_server.CreatureResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/me/dex": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get every species with whether the player has discovered it and how many they have caught",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Get Player Dex",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Player ID (defaults to the caller, must be the caller's)",
+                        "name": "player_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.DexResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/me/eggs": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all eggs belonging to a player with their lifecycle state and the time left until the next state",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Get Player Eggs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Player ID (defaults to the caller, must be the caller's)",
+                        "name": "player_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/internal_server.GetEggsByPlayerResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Player drops an egg (adds to inventory + eggs table). The egg type's coin cost is charged.\nRejections carry an error_code: DROP_LEVEL_TOO_LOW, DROP_EGG_TYPE_LOCKED, DROP_INSUFFICIENT_COINS, DROP_COOLDOWN, DROP_TOO_MANY_ACTIVE_EGGS, DROP_TOO_CLOSE_TO_EGG or DROP_EXCLUDED_AREA.\nThe drop location is checked against the caller's recent locations; one that looks faked is rejected (LOCATION_REJECTED) or holds the caller's location based actions for a while (LOCATION_ON_HOLD).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Drop Egg",
+                "parameters": [
+                    {
+                        "description": "Drop Egg Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.DropEggRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.DropEggResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "402": {
+                        "description": "Payment Required",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/me/inventory": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all inventory items (tools, eggs, boosts) belonging to a player",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Get Player Inventory",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Player ID (defaults to the caller, must be the caller's)",
+                        "name": "player_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/internal_server.Inventory"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/me/ledger": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the history of a player's coin and XP changes, newest first, with the balance after each change",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Get Player Ledger",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Player ID (defaults to the caller, must be the caller's)",
+                        "name": "player_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only entries of this currency (COIN or XP)",
+                        "name": "currency",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 50, max 200)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/internal_server.LedgerEntryResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/me/location": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Report the caller's current position. Clients send it periodically while the app is open; hatching and collecting eggs check the distance from the last reported position. Distances between positions reported in a row count as travelled on the leaderboards. Positions that look faked compared to the caller's recent ones are rejected (LOCATION_REJECTED) or put the caller's location based actions on hold.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Report Location",
+                "parameters": [
+                    {
+                        "description": "Current position",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ReportLocationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.LocationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/me/quests": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the caller's daily and weekly quests with their progress. Quests are picked per player and reset at local midnight in the time zone set in the player's settings (UTC by default); weekly quests reset on Monday.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "List Quests",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/internal_server.QuestResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/me/quests/{code}/claim": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Claim the coin and XP reward of a completed quest of the current day or week. Each reward can be claimed once per period.\nRejections carry an error_code: QUEST_NOT_FOUND, QUEST_NOT_COMPLETED or QUEST_ALREADY_CLAIMED.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Claim Quest",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Quest code",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ClaimQuestResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/me/streak": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the caller's login streak and the daily rewards calendar. Every day with activity, in the time zone set in the player's settings, extends the streak; a missed day resets it unless a streak freeze covers it. The streak day picks the daily reward, repeating after day 7.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Get Login Streak",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.StreakCalendarResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/me/streak/claim": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Claim the daily reward of the caller's streak day. A reward can be claimed once a day, days starting at local midnight.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Claim Daily Reward",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ClaimDailyRewardResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/me/tools": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all tools belonging to a player",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Get Player Tools",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Player ID (defaults to the caller, must be the caller's)",
+                        "name": "player_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/internal_server.GetToolsByPlayerResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/me/tools/{id}/equip": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Equip one of the caller's tools. Any other tool in the same slot is unequipped.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Equip Tool",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tool inventory ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.GetToolsByPlayerResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/me/tools/{id}/unequip": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Unequip one of the caller's tools",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Unequip Tool",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tool inventory ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.GetToolsByPlayerResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/player": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get player stats by account id, with the XP into the current level, the XP needed for the next one, the login streak and the next daily reward",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Get Player Stats",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Account ID (defaults to the caller, must be the caller's)",
+                        "name": "account_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.PlayerStatsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/quests": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the caller's daily and weekly quests with their progress. Quests are picked per player and reset at local midnight in the time zone set in the player's settings (UTC by default); weekly quests reset on Monday.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "List Quests",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/internal_server.QuestResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/quests/{code}/claim": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Claim the coin and XP reward of a completed quest of the current day or week. Each reward can be claimed once per period.\nRejections carry an error_code: QUEST_NOT_FOUND, QUEST_NOT_COMPLETED or QUEST_ALREADY_CLAIMED.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Claim Quest",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Quest code",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ClaimQuestResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/shop": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the tools, boosts and egg type unlocks for sale",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "List Shop Items",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/internal_server.ShopItemResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/shop/purchase": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Buy packs of a shop item with coins. Coins are debited and the items created atomically.\nRetrying with the same request key returns the original purchase instead of charging again.\nRejections carry an error_code: SHOP_ITEM_NOT_FOUND, SHOP_LEVEL_TOO_LOW, SHOP_INSUFFICIENT_COINS, SHOP_ALREADY_UNLOCKED or SHOP_REQUEST_KEY_REUSED.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Purchase Shop Item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Request key, if not in the body",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Purchase",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.PurchaseRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.PurchaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "402": {
+                        "description": "Payment Required",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/spawns/nearby": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get eggs placed by the world spawner around a position, sorted by distance. Spawns belong to no player and disappear when nobody collects them in time.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Get Nearby Spawns",
+                "parameters": [
+                    {
+                        "type": "number",
+                        "description": "Latitude of the caller",
+                        "name": "lat",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Longitude of the caller",
+                        "name": "lon",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Search radius in meters (default 500, max 5000)",
+                        "name": "radius",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 50, max 200)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/internal_server.NearbySpawnResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/spawns/{id}/claim": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Pick up an egg the world spawner placed. The caller's last reported location must be within the configured hatch distance of the spawn. The spawn becomes an egg in the caller's inventory that starts incubating on pickup and can be hatched anywhere; the caller earns half of the egg type's hatch reward.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Claim Spawn",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Spawn ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ClaimSpawnResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/streak": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the caller's login streak and the daily rewards calendar. Every day with activity, in the time zone set in the player's settings, extends the streak; a missed day resets it unless a streak freeze covers it. The streak day picks the daily reward, repeating after day 7.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Get Login Streak",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.StreakCalendarResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/streak/claim": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Claim the daily reward of the caller's streak day. A reward can be claimed once a day, days starting at local midnight.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Claim Daily Reward",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ClaimDailyRewardResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/tools": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all tools belonging to a player",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Get Player Tools",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Player ID (defaults to the caller, must be the caller's)",
+                        "name": "player_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/internal_server.GetToolsByPlayerResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/tools/{id}/equip": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Equip one of the caller's tools. Any other tool in the same slot is unequipped.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Equip Tool",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tool inventory ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.GetToolsByPlayerResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/game/tools/{id}/unequip": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Unequip one of the caller's tools",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "game"
+                ],
+                "summary": "Unequip Tool",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tool inventory ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.GetToolsByPlayerResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "github_com_0xdbb_eggsplore_internal_game.EggReward": {
+            "type": "object",
+            "properties": {
+                "coins": {
+                    "type": "integer"
+                },
+                "xp": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_0xdbb_eggsplore_internal_game.HintBand": {
+            "type": "string",
+            "enum": [
+                "HOT",
+                "WARM",
+                "COLD"
+            ],
+            "x-enum-varnames": [
+                "HintHot",
+                "HintWarm",
+                "HintCold"
+            ]
+        },
+        "github_com_0xdbb_eggsplore_internal_game.Signal": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "score": {
+                    "type": "integer"
+                }
+            }
+        },
+        "internal_server.AccountLoginRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "john.doe@example.com"
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 8,
+                    "example": "password123{#Pbb"
+                }
+            }
+        },
+        "internal_server.AchievementResponse": {
+            "type": "object",
+            "properties": {
+                "claimed": {
+                    "type": "boolean"
+                },
+                "claimed_at": {
+                    "type": "string"
+                },
+                "code": {
+                    "type": "string",
+                    "example": "HATCH_10"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Hatch 10 eggs."
+                },
+                "egg_type": {
+                    "type": "string"
+                },
+                "event": {
+                    "type": "string",
+                    "example": "EGG_HATCHED"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Hatchery"
+                },
+                "progress": {
+                    "description": "capped at the target",
+                    "type": "integer",
+                    "example": 4
+                },
+                "reward_coins": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "reward_xp": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "target": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "unlocked": {
+                    "type": "boolean"
+                },
+                "unlocked_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "internal_server.ActivateBoostResponse": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "$ref": "#/definitions/internal_server.ActiveBoostResponse"
+                },
+                "remaining": {
+                    "description": "boosts left in the stack",
+                    "type": "integer"
+                }
+            }
+        },
+        "internal_server.ActiveBoostResponse": {
+            "type": "object",
+            "properties": {
+                "activated_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string",
+                    "example": "XP_MULTIPLIER"
+                },
+                "multiplier": {
+                    "type": "number",
+                    "example": 2
+                },
+                "time_left_seconds": {
+                    "type": "integer",
+                    "example": 1800
+                }
+            }
+        },
+        "internal_server.BoostItemResponse": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "duration_seconds": {
+                    "type": "integer",
+                    "example": 1800
+                },
+                "inventory_id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string",
+                    "example": "XP_MULTIPLIER"
+                },
+                "multiplier": {
+                    "type": "number",
+                    "example": 2
+                },
+                "quantity": {
+                    "type": "integer"
+                }
+            }
+        },
+        "internal_server.ClaimAchievementResponse": {
+            "type": "object",
+            "properties": {
+                "achievement": {
+                    "$ref": "#/definitions/internal_server.AchievementResponse"
+                },
+                "coins": {
+                    "description": "player's balance after the reward",
+                    "type": "integer"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "level_ups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_server.LevelUpResponse"
+                    }
+                },
+                "xp": {
+                    "type": "integer"
+                }
+            }
+        },
+        "internal_server.ClaimDailyRewardResponse": {
+            "type": "object",
+            "properties": {
+                "coins": {
+                    "description": "player's balance after the reward",
+                    "type": "integer"
+                },
+                "freezes": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "inventory_ids": {
+                    "description": "items granted",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "level_ups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_server.LevelUpResponse"
+                    }
+                },
+                "reward": {
+                    "$ref": "#/definitions/internal_server.DailyRewardResponse"
+                },
+                "streak": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "xp": {
+                    "type": "integer"
+                }
+            }
+        },
+        "internal_server.ClaimQuestResponse": {
+            "type": "object",
+            "properties": {
+                "coins": {
+                    "description": "player's balance after the reward",
+                    "type": "integer"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "level_ups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_server.LevelUpResponse"
+                    }
+                },
+                "quest": {
+                    "$ref": "#/definitions/internal_server.QuestResponse"
+                },
+                "xp": {
+                    "type": "integer"
+                }
+            }
+        },
+        "internal_server.ClaimSpawnResponse": {
+            "type": "object",
+            "properties": {
+                "coins": {
+                    "description": "caller's balance after the reward",
+                    "type": "integer"
+                },
+                "collected_at": {
+                    "type": "string"
+                },
+                "completed_quests": {
+                    "description": "codes of quests now claimable",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "decays_at": {
+                    "type": "string"
+                },
+                "inventory_id": {
+                    "type": "string"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "level_ups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_server.LevelUpResponse"
+                    }
+                },
+                "ready_at": {
+                    "type": "string"
+                },
+                "reward": {
+                    "$ref": "#/definitions/github_com_0xdbb_eggsplore_internal_game.EggReward"
+                },
+                "state": {
+                    "type": "string",
+                    "example": "PLANTED"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "unlocked_achievements": {
+                    "description": "codes of achievements now claimable",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "xp": {
+                    "type": "integer"
+                }
+            }
+        },
+        "internal_server.CollectEggResponse": {
+            "type": "object",
+            "properties": {
+                "coins": {
+                    "description": "caller's balance after the reward",
+                    "type": "integer"
+                },
+                "collected_at": {
+                    "type": "string"
+                },
+                "completed_quests": {
+                    "description": "codes of quests now claimable",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "dropper_id": {
+                    "type": "string"
+                },
+                "dropper_reward": {
+                    "description": "what the dropper earned",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_0xdbb_eggsplore_internal_game.EggReward"
+                        }
+                    ]
+                },
+                "inventory_id": {
+                    "type": "string"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "level_ups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_server.LevelUpResponse"
+                    }
+                },
+                "reward": {
+                    "description": "what the caller earned",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_0xdbb_eggsplore_internal_game.EggReward"
+                        }
+                    ]
+                },
+                "type": {
+                    "type": "string"
+                },
+                "unlocked_achievements": {
+                    "description": "codes of achievements now claimable",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "xp": {
+                    "type": "integer"
+                }
+            }
+        },
+        "internal_server.CreateExclusionZoneRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "bbox": {
+                    "$ref": "#/definitions/util.BoundingBox"
+                },
+                "center": {
+                    "$ref": "#/definitions/util.Coord"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "geometry": {
+                    "type": "object"
+                },
+                "half_size_meters": {
+                    "description": "with center",
+                    "type": "number",
+                    "example": 150
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Riverside Primary School"
+                }
+            }
+        },
+        "internal_server.CreateSpawnPointRequest": {
+            "type": "object",
+            "required": [
+                "lat",
+                "lon",
+                "name"
+            ],
+            "properties": {
+                "enabled": {
+                    "description": "default true",
+                    "type": "boolean"
+                },
+                "lat": {
+                    "type": "number",
+                    "maximum": 90,
+                    "minimum": -90
+                },
+                "lon": {
+                    "type": "number",
+                    "maximum": 180,
+                    "minimum": -180
+                },
+                "max_active": {
+                    "description": "default 5",
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 5
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Central Park Fountain"
+                },
+                "radius_meters": {
+                    "description": "default 200, max 5000",
+                    "type": "number",
+                    "example": 200
+                }
+            }
+        },
+        "internal_server.CreatureResponse": {
+            "type": "object",
+            "properties": {
+                "egg_id": {
+                    "type": "string"
+                },
+                "egg_type": {
+                    "type": "string",
+                    "example": "BUNNY"
+                },
+                "hatched_at": {
+                    "type": "string"
+                },
+                "inventory_id": {
+                    "type": "string"
+                },
+                "nickname": {
+                    "type": "string"
+                },
+                "rarity": {
+                    "type": "string",
+                    "example": "RARE"
+                },
+                "species_code": {
+                    "type": "string",
+                    "example": "MOON_BUNNY"
+                },
+                "species_id": {
+                    "type": "integer"
+                },
+                "species_name": {
+                    "type": "string",
+                    "example": "Moon Bunny"
+                }
+            }
+        },
+        "internal_server.DailyRewardResponse": {
+            "type": "object",
+            "properties": {
+                "coins": {
+                    "type": "integer",
+                    "example": 150
+                },
+                "day": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "packs": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "shop_item_code": {
+                    "type": "string",
+                    "example": "STREAK_FREEZE"
+                },
+                "xp": {
+                    "type": "integer",
+                    "example": 75
+                }
+            }
+        },
+        "internal_server.DexEntryResponse": {
+            "type": "object",
+            "properties": {
+                "caught": {
+                    "type": "integer"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "discovered": {
+                    "type": "boolean"
+                },
+                "egg_type": {
+                    "type": "string",
+                    "example": "BUNNY"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "???"
+                },
+                "rarity": {
+                    "type": "string",
+                    "example": "RARE"
+                },
+                "species_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "internal_server.DexResponse": {
+            "type": "object",
+            "properties": {
+                "discovered": {
+                    "type": "integer"
+                },
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_server.DexEntryResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "internal_server.DropEggRequest": {
+            "type": "object",
+            "required": [
+                "lat",
+                "lon",
+                "type"
+            ],
+            "properties": {
+                "accuracy": {
+                    "description": "meters, as reported by the device",
+                    "type": "number",
+                    "maximum": 10000,
+                    "minimum": 0,
+                    "example": 8.5
+                },
+                "lat": {
+                    "type": "number"
+                },
+                "lon": {
+                    "type": "number"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "player_id": {
+                    "description": "optional, must be the caller's",
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "DRAGON"
+                }
+            }
+        },
+        "internal_server.DropEggResponse": {
+            "type": "object",
+            "properties": {
+                "coins": {
+                    "description": "player's balance after the drop",
+                    "type": "integer"
+                },
+                "completed_quests": {
+                    "description": "codes of quests now claimable",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "cost": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "decays_at": {
+                    "type": "string"
+                },
+                "inventory_id": {
+                    "type": "string"
+                },
+                "lat": {
+                    "type": "number"
+                },
+                "lon": {
+                    "type": "number"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "ready_at": {
+                    "type": "string"
+                },
+                "shielded": {
+                    "type": "boolean"
+                },
+                "state": {
+                    "type": "string",
+                    "example": "PLANTED"
+                },
+                "tool": {
+                    "description": "Tool is the equipped shield that protects the egg, if any",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/internal_server.ToolWearResponse"
+                        }
+                    ]
+                },
+                "type": {
+                    "type": "string"
+                },
+                "unlocked_achievements": {
+                    "description": "codes of achievements now claimable",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "internal_server.EggClusterResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "lat": {
+                    "description": "mean position of the eggs' blur cells",
+                    "type": "number",
+                    "example": 5.8998
+                },
+                "lon": {
+                    "type": "number",
+                    "example": -2.03874
+                },
+                "types": {
+                    "description": "egg type to count",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "internal_server.EggClustersResponse": {
+            "type": "object",
+            "properties": {
+                "tiles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_server.EggTileResponse"
+                    }
+                },
+                "zoom": {
+                    "type": "integer",
+                    "example": 12
+                }
+            }
+        },
+        "internal_server.EggHintResponse": {
+            "type": "object",
+            "properties": {
+                "area": {
+                    "$ref": "#/definitions/internal_server.HintAreaResponse"
+                },
+                "band": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_0xdbb_eggsplore_internal_game.HintBand"
+                        }
+                    ],
+                    "example": "WARM"
+                },
+                "direction": {
+                    "description": "compass point from the caller to the egg",
+                    "type": "string",
+                    "example": "NE"
+                },
+                "inventory_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "internal_server.EggPointResponse": {
+            "type": "object",
+            "properties": {
+                "inventory_id": {
+                    "type": "string"
+                },
+                "lat": {
+                    "type": "number"
+                },
+                "lon": {
+                    "type": "number"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "internal_server.EggTileResponse": {
+            "type": "object",
+            "properties": {
+                "bounds": {
+                    "$ref": "#/definitions/util.BoundingBox"
+                },
+                "clusters": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_server.EggClusterResponse"
+                    }
+                },
+                "eggs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_server.EggPointResponse"
+                    }
+                },
+                "tile": {
+                    "$ref": "#/definitions/util.Tile"
+                }
+            }
+        },
+        "internal_server.EggTypeResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "DRAGON"
+                },
+                "coin_cost": {
+                    "type": "integer"
+                },
+                "decay_seconds": {
+                    "type": "integer"
+                },
+                "incubation_seconds": {
+                    "type": "integer"
+                },
+                "min_level": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Dragon Egg"
+                },
+                "rarity": {
+                    "type": "string",
+                    "example": "EPIC"
+                },
+                "requires_unlock": {
+                    "type": "boolean"
+                },
+                "reward": {
+                    "$ref": "#/definitions/github_com_0xdbb_eggsplore_internal_game.EggReward"
+                }
+            }
+        },
+        "internal_server.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "error_code": {
+                    "description": "ErrorCode is a machine-readable reason, set for rule rejections",
+                    "type": "string",
+                    "example": "DROP_COOLDOWN"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "internal_server.ExclusionZoneResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "geometry": {
+                    "type": "object"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "internal_server.GetEggsByPlayerResponse": {
+            "type": "object",
+            "properties": {
+                "collected_at": {
+                    "type": "string"
+                },
+                "hatched": {
+                    "type": "boolean"
+                },
+                "inventory_id": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "next_state": {
+                    "type": "string",
+                    "example": "READY"
+                },
+                "next_state_at": {
+                    "type": "string"
+                },
+                "planted_at": {
+                    "type": "string"
+                },
+                "state": {
+                    "type": "string",
+                    "example": "INCUBATING"
+                },
+                "time_left_seconds": {
+                    "type": "integer",
+                    "example": 1800
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "internal_server.GetToolsByPlayerResponse": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "durability": {
+                    "type": "integer"
+                },
+                "equipped": {
+                    "type": "boolean"
+                },
+                "inventory_id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string",
+                    "example": "RADAR"
+                },
+                "slot": {
+                    "type": "string",
+                    "example": "DEVICE"
+                }
+            }
+        },
+        "internal_server.HatchEggResponse": {
+            "type": "object",
+            "properties": {
+                "coins": {
+                    "description": "player's balance after the reward",
+                    "type": "integer"
+                },
+                "completed_quests": {
+                    "description": "codes of quests now claimable",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "creature": {
+                    "$ref": "#/definitions/internal_server.CreatureResponse"
+                },
+                "hatched_at": {
+                    "type": "string"
+                },
+                "inventory_id": {
+                    "type": "string"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "level_ups": {
+                    "description": "levels reached through the hatch XP",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_server.LevelUpResponse"
+                    }
+                },
+                "reward": {
+                    "$ref": "#/definitions/github_com_0xdbb_eggsplore_internal_game.EggReward"
+                },
+                "state": {
+                    "type": "string",
+                    "example": "HATCHED"
+                },
+                "tool": {
+                    "description": "equipped shovel used, if any",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/internal_server.ToolWearResponse"
+                        }
+                    ]
+                },
+                "type": {
+                    "type": "string"
+                },
+                "unlocked_achievements": {
+                    "description": "codes of achievements now claimable",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "xp": {
+                    "type": "integer"
+                }
+            }
+        },
+        "internal_server.HintAreaResponse": {
+            "type": "object",
+            "properties": {
+                "center": {
+                    "$ref": "#/definitions/util.Coord"
+                },
+                "radius": {
+                    "description": "meters",
+                    "type": "number",
+                    "example": 500
+                }
+            }
+        },
+        "internal_server.Inventory": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "item_type": {
+                    "type": "string"
+                },
+                "player_id": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                }
+            }
+        },
+        "internal_server.LeaderboardAroundResponse": {
+            "type": "object",
+            "properties": {
+                "board": {
+                    "type": "string",
+                    "example": "xp"
+                },
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_server.LeaderboardEntryResponse"
+                    }
+                },
+                "me": {
+                    "description": "null when the caller is not ranked yet",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/internal_server.LeaderboardEntryResponse"
+                        }
+                    ]
+                },
+                "region": {
+                    "type": "string",
+                    "example": "Europe"
+                },
+                "scope": {
+                    "type": "string",
+                    "example": "global"
+                }
+            }
+        },
+        "internal_server.LeaderboardEntryResponse": {
+            "type": "object",
+            "properties": {
+                "player_id": {
+                    "type": "string"
+                },
+                "rank": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "score": {
+                    "type": "number",
+                    "example": 1250
+                },
+                "username": {
+                    "type": "string",
+                    "example": "eggsplorer"
+                }
+            }
+        },
+        "internal_server.LeaderboardResponse": {
+            "type": "object",
+            "properties": {
+                "board": {
+                    "type": "string",
+                    "example": "xp"
+                },
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_server.LeaderboardEntryResponse"
+                    }
+                },
+                "region": {
+                    "type": "string",
+                    "example": "Europe"
+                },
+                "scope": {
+                    "type": "string",
+                    "example": "global"
+                },
+                "total": {
+                    "description": "players on the board",
+                    "type": "integer"
+                }
+            }
+        },
+        "internal_server.LedgerEntryResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer",
+                    "example": -25
+                },
+                "balance_after": {
+                    "type": "integer",
+                    "example": 75
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "COIN"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "EGG_DROP"
+                },
+                "reference_id": {
+                    "type": "string"
+                },
+                "reference_type": {
+                    "type": "string",
+                    "example": "egg"
+                },
+                "transaction_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "internal_server.LevelUpResponse": {
+            "type": "object",
+            "properties": {
+                "coins": {
+                    "type": "integer",
+                    "example": 75
+                },
+                "egg_type": {
+                    "type": "string"
+                },
+                "inventory_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "level": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "internal_server.LocationIncidentResponse": {
+            "type": "object",
+            "properties": {
+                "accuracy": {
+                    "description": "meters, unset when the client did not report it",
+                    "type": "number"
+                },
+                "action": {
+                    "type": "string",
+                    "example": "DELAY"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "hold_until": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "lat": {
+                    "type": "number"
+                },
+                "lon": {
+                    "type": "number"
+                },
+                "player_id": {
+                    "type": "string"
+                },
+                "review": {
+                    "type": "string",
+                    "example": "CONFIRMED"
+                },
+                "review_note": {
+                    "type": "string"
+                },
+                "reviewed_at": {
+                    "type": "string"
+                },
+                "reviewed_by": {
+                    "type": "string"
+                },
+                "score": {
+                    "type": "integer",
+                    "example": 50
+                },
+                "signals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_0xdbb_eggsplore_internal_game.Signal"
+                    }
+                },
+                "source": {
+                    "type": "string",
+                    "example": "HEARTBEAT"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "internal_server.LocationResponse": {
+            "type": "object",
+            "properties": {
+                "accuracy": {
+                    "description": "meters, unset when the device did not report it",
+                    "type": "number",
+                    "example": 8.5
+                },
+                "hold_until": {
+                    "description": "location based actions wait until then, when the location looked faked",
+                    "type": "string"
+                },
+                "lat": {
+                    "type": "number"
+                },
+                "lon": {
+                    "type": "number"
+                },
+                "recorded_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "internal_server.Message": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 500
+                },
+                "error": {
+                    "type": "string",
+                    "example": "Failed to Process Task"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Task status updated - COMPLETED"
+                },
+                "result": {
+                    "type": "string",
+                    "example": "Task processed successfully"
+                },
+                "task_id": {
+                    "type": "string",
+                    "example": "1233-flf4djf-alsdik"
+                }
+            }
+        },
+        "internal_server.NearbyEggResponse": {
+            "type": "object",
+            "properties": {
+                "distance": {
+                    "description": "meters",
+                    "type": "number",
+                    "example": 42.5
+                },
+                "inventory_id": {
+                    "type": "string"
+                },
+                "lat": {
+                    "type": "number"
+                },
+                "lon": {
+                    "type": "number"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "owner_id": {
+                    "type": "string"
+                },
+                "owner_username": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "internal_server.NearbySpawnResponse": {
+            "type": "object",
+            "properties": {
+                "distance": {
+                    "description": "meters",
+                    "type": "number",
+                    "example": 42.5
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "lat": {
+                    "type": "number"
+                },
+                "lon": {
+                    "type": "number"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "BUNNY"
+                }
+            }
+        },
+        "internal_server.PlayerBoostsResponse": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_server.ActiveBoostResponse"
+                    }
+                },
+                "owned": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_server.BoostItemResponse"
+                    }
+                }
+            }
+        },
+        "internal_server.PlayerStatsResponse": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "coins": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "max_level": {
+                    "type": "integer",
+                    "example": 50
+                },
+                "settings": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "streak": {
+                    "$ref": "#/definitions/internal_server.StreakResponse"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "xp": {
+                    "type": "integer"
+                },
+                "xp_for_next_level": {
+                    "description": "XP the next level takes from the start of this one, 0 at the max level",
+                    "type": "integer",
+                    "example": 183
+                },
+                "xp_into_level": {
+                    "type": "integer",
+                    "example": 80
+                },
+                "xp_to_next_level": {
+                    "type": "integer",
+                    "example": 103
+                }
+            }
+        },
+        "internal_server.PurchaseRequest": {
+            "type": "object",
+            "required": [
+                "item_code"
+            ],
+            "properties": {
+                "item_code": {
+                    "type": "string",
+                    "example": "DOUBLE_XP"
+                },
+                "packs": {
+                    "type": "integer",
+                    "maximum": 10,
+                    "minimum": 1,
+                    "example": 1
+                },
+                "request_key": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "3f2b6c1e-purchase-1"
+                }
+            }
+        },
+        "internal_server.PurchaseResponse": {
+            "type": "object",
+            "properties": {
+                "coins": {
+                    "description": "player's balance after the purchase",
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "inventory_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "item_code": {
+                    "type": "string"
+                },
+                "packs": {
+                    "type": "integer"
+                },
+                "price": {
+                    "description": "total charged",
+                    "type": "integer"
+                },
+                "purchase_id": {
+                    "type": "string"
+                },
+                "replayed": {
+                    "description": "the request key was used before, nothing new was charged",
+                    "type": "boolean"
+                }
+            }
+        },
+        "internal_server.QuestResponse": {
+            "type": "object",
+            "properties": {
+                "claimed": {
+                    "type": "boolean"
+                },
+                "claimed_at": {
+                    "type": "string"
+                },
+                "code": {
+                    "type": "string",
+                    "example": "DAILY_HATCH_3"
+                },
+                "completed": {
+                    "type": "boolean"
+                },
+                "completed_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Hatch 3 eggs."
+                },
+                "egg_type": {
+                    "type": "string"
+                },
+                "event": {
+                    "type": "string",
+                    "example": "EGG_HATCHED"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Busy Nest"
+                },
+                "period": {
+                    "type": "string",
+                    "example": "DAILY"
+                },
+                "progress": {
+                    "description": "capped at the target",
+                    "type": "integer",
+                    "example": 1
+                },
+                "resets_at": {
+                    "description": "local midnight the quest is replaced at",
+                    "type": "string"
+                },
+                "reward_coins": {
+                    "type": "integer",
+                    "example": 50
+                },
+                "reward_xp": {
+                    "type": "integer",
+                    "example": 50
+                },
+                "target": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "internal_server.RegisterAccountRequest": {
             "type": "object",
             "required": [
                 "email",
-                "password"
+                "password",
+                "username"
             ],
             "properties": {
                 "email": {
                     "type": "string",
                     "example": "john.doe@example.com"
                 },
+                "first_name": {
+                    "type": "string",
+                    "example": "John"
+                },
+                "last_name": {
+                    "type": "string",
+                    "example": "Doe"
+                },
                 "password": {
                     "type": "string",
                     "minLength": 8,
                     "example": "password123{#Pbb"
+                },
+                "username": {
+                    "type": "string",
+                    "maxLength": 30,
+                    "minLength": 3,
+                    "example": "John_doe11"
                 }
             }
         },
-        "internal_server.DropEggRequest": {
+        "internal_server.ReportLocationRequest": {
             "type": "object",
             "required": [
                 "lat",
-                "lon",
-                "player_id",
-                "type"
+                "lon"
             ],
             "properties": {
+                "accuracy": {
+                    "description": "meters, as reported by the device",
+                    "type": "number",
+                    "maximum": 10000,
+                    "minimum": 0,
+                    "example": 8.5
+                },
                 "lat": {
-                    "type": "number"
+                    "type": "number",
+                    "maximum": 90,
+                    "minimum": -90
                 },
                 "lon": {
-                    "type": "number"
-                },
-                "message": {
-                    "type": "string"
-                },
-                "player_id": {
+                    "type": "number",
+                    "maximum": 180,
+                    "minimum": -180
+                }
+            }
+        },
+        "internal_server.ReviewLocationIncidentRequest": {
+            "type": "object",
+            "required": [
+                "review"
+            ],
+            "properties": {
+                "note": {
                     "type": "string"
                 },
-                "type": {
+                "review": {
                     "type": "string",
                     "enum": [
-                        "BUNNY",
-                        "GOLDEN",
-                        "LEGENDARY"
-                    ]
+                        "CONFIRMED",
+                        "DISMISSED"
+                    ],
+                    "example": "DISMISSED"
                 }
             }
         },
-        "internal_server.DropEggResponse": {
+        "internal_server.ShopItemResponse": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "type": "string"
+                "boost_duration_seconds": {
+                    "type": "integer",
+                    "example": 1800
                 },
-                "inventory_id": {
-                    "type": "string"
+                "boost_kind": {
+                    "type": "string",
+                    "example": "XP_MULTIPLIER"
                 },
-                "lat": {
-                    "type": "number"
+                "boost_multiplier": {
+                    "type": "number",
+                    "example": 2
                 },
-                "lon": {
-                    "type": "number"
+                "code": {
+                    "type": "string",
+                    "example": "DOUBLE_XP"
                 },
-                "message": {
+                "description": {
                     "type": "string"
                 },
-                "type": {
-                    "type": "string"
-                }
-            }
-        },
-        "internal_server.ErrorResponse": {
-            "type": "object",
-            "properties": {
-                "code": {
+                "egg_type": {
+                    "type": "string",
+                    "example": "LEGENDARY"
+                },
+                "item_type": {
+                    "type": "string",
+                    "example": "BOOST"
+                },
+                "min_level": {
                     "type": "integer"
                 },
-                "message": {
-                    "type": "string"
+                "name": {
+                    "type": "string",
+                    "example": "Double XP"
                 },
-                "status": {
-                    "type": "string"
+                "price": {
+                    "type": "integer",
+                    "example": 50
+                },
+                "quantity": {
+                    "description": "units per pack",
+                    "type": "integer",
+                    "example": 1
+                },
+                "tool_kind": {
+                    "type": "string",
+                    "example": "RADAR"
                 }
             }
         },
-        "internal_server.GetEggsByPlayerResponse": {
+        "internal_server.SpawnPointResponse": {
             "type": "object",
             "properties": {
-                "collected_at": {
+                "created_at": {
                     "type": "string"
                 },
-                "hatched": {
+                "enabled": {
                     "type": "boolean"
                 },
-                "inventory_id": {
-                    "type": "string"
+                "id": {
+                    "type": "integer"
                 },
-                "message": {
-                    "type": "string"
+                "lat": {
+                    "type": "number"
                 },
-                "type": {
-                    "type": "string"
-                }
-            }
-        },
-        "internal_server.GetToolsByPlayerResponse": {
-            "type": "object",
-            "properties": {
-                "description": {
-                    "type": "string"
+                "lon": {
+                    "type": "number"
                 },
-                "durability": {
+                "max_active": {
+                    "description": "spawns kept within the radius at most",
                     "type": "integer"
                 },
-                "equipped": {
-                    "type": "boolean"
-                },
-                "inventory_id": {
+                "name": {
                     "type": "string"
+                },
+                "radius_meters": {
+                    "type": "number"
                 }
             }
         },
-        "internal_server.Inventory": {
+        "internal_server.StreakCalendarResponse": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "type": "string"
+                "calendar": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_server.DailyRewardResponse"
+                    }
                 },
-                "description": {
-                    "type": "string"
+                "claimed_today": {
+                    "type": "boolean"
                 },
-                "id": {
-                    "type": "string"
+                "current": {
+                    "description": "consecutive days with activity, including today",
+                    "type": "integer",
+                    "example": 4
                 },
-                "item_type": {
-                    "type": "string"
+                "freezes": {
+                    "description": "missed days the streak survives",
+                    "type": "integer",
+                    "example": 1
                 },
-                "player_id": {
-                    "type": "string"
+                "longest": {
+                    "type": "integer",
+                    "example": 12
                 },
-                "quantity": {
-                    "type": "integer"
+                "next_reward": {
+                    "$ref": "#/definitions/internal_server.DailyRewardResponse"
+                },
+                "next_reward_at": {
+                    "description": "now when it can be claimed, otherwise the next local midnight",
+                    "type": "string"
                 }
             }
         },
-        "internal_server.Message": {
+        "internal_server.StreakResponse": {
             "type": "object",
             "properties": {
-                "code": {
+                "claimed_today": {
+                    "type": "boolean"
+                },
+                "current": {
+                    "description": "consecutive days with activity, including today",
                     "type": "integer",
-                    "example": 500
+                    "example": 4
                 },
-                "error": {
-                    "type": "string",
-                    "example": "Failed to Process Task"
+                "freezes": {
+                    "description": "missed days the streak survives",
+                    "type": "integer",
+                    "example": 1
                 },
-                "message": {
-                    "type": "string",
-                    "example": "Task status updated - COMPLETED"
+                "longest": {
+                    "type": "integer",
+                    "example": 12
                 },
-                "result": {
-                    "type": "string",
-                    "example": "Task processed successfully"
+                "next_reward": {
+                    "$ref": "#/definitions/internal_server.DailyRewardResponse"
                 },
-                "task_id": {
-                    "type": "string",
-                    "example": "1233-flf4djf-alsdik"
+                "next_reward_at": {
+                    "description": "now when it can be claimed, otherwise the next local midnight",
+                    "type": "string"
                 }
             }
         },
-        "internal_server.Players": {
+        "internal_server.ToolWearResponse": {
             "type": "object",
             "properties": {
-                "account_id": {
-                    "type": "string"
-                },
-                "coins": {
-                    "type": "integer"
-                },
-                "created_at": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
+                "broken": {
+                    "type": "boolean"
                 },
-                "level": {
+                "durability": {
+                    "description": "remaining",
                     "type": "integer"
                 },
-                "settings": {
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
-                },
-                "updated_at": {
+                "inventory_id": {
                     "type": "string"
                 },
-                "xp": {
-                    "type": "integer"
+                "kind": {
+                    "type": "string",
+                    "example": "SHOVEL"
                 }
             }
         },
-        "internal_server.RegisterAccountRequest": {
+        "internal_server.UserMessage": {
             "type": "object",
-            "required": [
-                "email",
-                "password",
-                "username"
-            ],
             "properties": {
-                "email": {
-                    "type": "string",
-                    "example": "john.doe@example.com"
-                },
-                "first_name": {
-                    "type": "string",
-                    "example": "John"
-                },
-                "last_name": {
-                    "type": "string",
-                    "example": "Doe"
-                },
-                "password": {
-                    "type": "string",
-                    "minLength": 8,
-                    "example": "password123{#Pbb"
-                },
-                "username": {
+                "message": {
                     "type": "string",
-                    "maxLength": 30,
-                    "minLength": 3,
-                    "example": "John_doe11"
+                    "example": "success"
                 }
             }
         },
@@ -681,6 +5717,61 @@ const docTemplate = `{
                     "type": "string"
                 }
             }
+        },
+        "util.BoundingBox": {
+            "type": "object",
+            "properties": {
+                "xmax": {
+                    "type": "number",
+                    "example": -1.399792495999975
+                },
+                "xmin": {
+                    "type": "number",
+                    "example": -3.109048267999981
+                },
+                "ymax": {
+                    "type": "number",
+                    "example": 6.15031057300007
+                },
+                "ymin": {
+                    "type": "number",
+                    "example": 4.738773972000047
+                }
+            }
+        },
+        "util.Coord": {
+            "type": "object",
+            "required": [
+                "lat",
+                "lon"
+            ],
+            "properties": {
+                "lat": {
+                    "type": "number",
+                    "example": 5.8998
+                },
+                "lon": {
+                    "type": "number",
+                    "example": -2.03874
+                }
+            }
+        },
+        "util.Tile": {
+            "type": "object",
+            "properties": {
+                "x": {
+                    "type": "integer",
+                    "example": 16201
+                },
+                "y": {
+                    "type": "integer",
+                    "example": 15834
+                },
+                "z": {
+                    "type": "integer",
+                    "example": 15
+                }
+            }
         }
     },
     "securityDefinitions": {
@@ -5,8 +5,11 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/0xdbb/eggsplore/internal/game"
 	"github.com/joho/godotenv"
 )
 
@@ -15,7 +18,8 @@ type Config struct {
 	Production string
 	Port       string
 
-	DbUrl string
+	DbUrl    string
+	RedisUrl string // optional, leaderboards are kept in memory without it
 
 	Recipients string
 	AdminEmail string
@@ -27,6 +31,42 @@ type Config struct {
 	TokenSecret          string
 	AccessTokenDuration  time.Duration
 	RefreshTokenDuration time.Duration
+
+	HatchRadiusMeters float64
+
+	DropCooldown        time.Duration
+	MaxActiveEggs       int64
+	MinEggSpacingMeters float64
+
+	StartingCoins int64
+	StarterTools  []string
+
+	XPCurveBase     float64
+	XPCurveExponent float64
+	MaxLevel        int64
+
+	SpawnInterval      time.Duration
+	SpawnTTL           time.Duration
+	SpawnActiveWindow  time.Duration
+	SpawnRadiusMeters  float64
+	SpawnMaxNearPlayer int64
+
+	LocationMaxAge        time.Duration // how old the last reported position may be for proximity checks
+	LocationRetention     time.Duration
+	LocationPruneInterval time.Duration
+
+	AntiCheatMaxSpeed    float64 // meters per second
+	AntiCheatMinAccuracy float64 // meters
+	AntiCheatDelayScore  int64
+	AntiCheatRejectScore int64
+	AntiCheatHold        time.Duration
+
+	HintRateLimit string // hints a player may ask for, e.g., 6-M for six a minute
+	HintSalt      string // seeds the offset of hint areas, changing it moves every area
+
+	TileCacheTTL time.Duration // how long egg clusters for a map tile are reused
+
+	LeaderboardFlushInterval time.Duration // how often queued leaderboard updates are written
 }
 
 // LoadConfig loads environment variables from the .env file (if it exists)
@@ -53,12 +93,114 @@ func LoadConfig(path ...string) (*Config, error) {
 		return nil, err
 	}
 
+	hatchRadius, err := parseFloatOr("HATCH_RADIUS_METERS", 30)
+	if err != nil {
+		return nil, err
+	}
+
+	dropCooldown, err := parseDurationOr("DROP_COOLDOWN", time.Minute)
+	if err != nil {
+		return nil, err
+	}
+	maxActiveEggs, err := parseIntOr("MAX_ACTIVE_EGGS", 10)
+	if err != nil {
+		return nil, err
+	}
+	minEggSpacing, err := parseFloatOr("MIN_EGG_SPACING_METERS", 25)
+	if err != nil {
+		return nil, err
+	}
+
+	startingCoins, err := parseIntOr("STARTING_COINS", 100)
+	if err != nil {
+		return nil, err
+	}
+
+	xpCurveBase, err := parseFloatOr("XP_CURVE_BASE", 100)
+	if err != nil {
+		return nil, err
+	}
+	xpCurveExponent, err := parseFloatOr("XP_CURVE_EXPONENT", 1.5)
+	if err != nil {
+		return nil, err
+	}
+	maxLevel, err := parseIntOr("MAX_LEVEL", 50)
+	if err != nil {
+		return nil, err
+	}
+
+	spawnInterval, err := parseDurationOr("SPAWN_INTERVAL", time.Minute)
+	if err != nil {
+		return nil, err
+	}
+	spawnTTL, err := parseDurationOr("SPAWN_TTL", 30*time.Minute)
+	if err != nil {
+		return nil, err
+	}
+	spawnActiveWindow, err := parseDurationOr("SPAWN_ACTIVE_WINDOW", 15*time.Minute)
+	if err != nil {
+		return nil, err
+	}
+	spawnRadius, err := parseFloatOr("SPAWN_RADIUS_METERS", 300)
+	if err != nil {
+		return nil, err
+	}
+	spawnMaxNearPlayer, err := parseIntOr("SPAWN_MAX_NEAR_PLAYER", 5)
+	if err != nil {
+		return nil, err
+	}
+
+	locationMaxAge, err := parseDurationOr("LOCATION_MAX_AGE", 2*time.Minute)
+	if err != nil {
+		return nil, err
+	}
+	locationRetention, err := parseDurationOr("LOCATION_RETENTION", 24*time.Hour)
+	if err != nil {
+		return nil, err
+	}
+	locationPruneInterval, err := parseDurationOr("LOCATION_PRUNE_INTERVAL", time.Hour)
+	if err != nil {
+		return nil, err
+	}
+
+	antiCheatMaxSpeed, err := parseFloatOr("ANTICHEAT_MAX_SPEED_MPS", 50)
+	if err != nil {
+		return nil, err
+	}
+	antiCheatMinAccuracy, err := parseFloatOr("ANTICHEAT_MIN_ACCURACY_METERS", 2)
+	if err != nil {
+		return nil, err
+	}
+	antiCheatDelayScore, err := parseIntOr("ANTICHEAT_DELAY_SCORE", 40)
+	if err != nil {
+		return nil, err
+	}
+	antiCheatRejectScore, err := parseIntOr("ANTICHEAT_REJECT_SCORE", 80)
+	if err != nil {
+		return nil, err
+	}
+	antiCheatHold, err := parseDurationOr("ANTICHEAT_HOLD", 5*time.Minute)
+	if err != nil {
+		return nil, err
+	}
+
+	tileCacheTTL, err := parseDurationOr("TILE_CACHE_TTL", 30*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	leaderboardFlushInterval, err := parseDurationOr("LEADERBOARD_FLUSH_INTERVAL", 5*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
 	// Populate config
 	config := &Config{
 		Production: os.Getenv("PRODUCTION"),
 		Port:       os.Getenv("PORT"),
 
-		DbUrl: os.Getenv("DB_URL"),
+		DbUrl:    os.Getenv("DB_URL"),
+		RedisUrl: os.Getenv("REDIS_URL"),
 
 		ResendApiKey: os.Getenv("RESEND_API_KEY"),
 
@@ -70,6 +212,42 @@ func LoadConfig(path ...string) (*Config, error) {
 
 		Recipients: os.Getenv("RECIPIENTS"),
 		AdminEmail: os.Getenv("ADMIN_EMAIL"),
+
+		HatchRadiusMeters: hatchRadius,
+
+		DropCooldown:        dropCooldown,
+		MaxActiveEggs:       maxActiveEggs,
+		MinEggSpacingMeters: minEggSpacing,
+
+		StartingCoins: startingCoins,
+		StarterTools:  parseListOr("STARTER_TOOLS", []string{"Radar", "Shovel"}),
+
+		XPCurveBase:     xpCurveBase,
+		XPCurveExponent: xpCurveExponent,
+		MaxLevel:        maxLevel,
+
+		SpawnInterval:      spawnInterval,
+		SpawnTTL:           spawnTTL,
+		SpawnActiveWindow:  spawnActiveWindow,
+		SpawnRadiusMeters:  spawnRadius,
+		SpawnMaxNearPlayer: spawnMaxNearPlayer,
+
+		LocationMaxAge:        locationMaxAge,
+		LocationRetention:     locationRetention,
+		LocationPruneInterval: locationPruneInterval,
+
+		AntiCheatMaxSpeed:    antiCheatMaxSpeed,
+		AntiCheatMinAccuracy: antiCheatMinAccuracy,
+		AntiCheatDelayScore:  antiCheatDelayScore,
+		AntiCheatRejectScore: antiCheatRejectScore,
+		AntiCheatHold:        antiCheatHold,
+
+		HintRateLimit: getenvOr("HINT_RATE_LIMIT", "6-M"),
+		HintSalt:      os.Getenv("HINT_SALT"),
+
+		TileCacheTTL: tileCacheTTL,
+
+		LeaderboardFlushInterval: leaderboardFlushInterval,
 	}
 
 	// Validate required vars
@@ -80,6 +258,38 @@ func LoadConfig(path ...string) (*Config, error) {
 	return config, nil
 }
 
+// XPCurve returns the configured curve of XP needed per level.
+func (c *Config) XPCurve() game.XPCurve {
+	return game.XPCurve{
+		Base:     c.XPCurveBase,
+		Exponent: c.XPCurveExponent,
+		MaxLevel: int32(c.MaxLevel),
+	}
+}
+
+// SpawnRules returns the configured limits of the world egg spawner. Spawns
+// keep the same spacing as dropped eggs.
+func (c *Config) SpawnRules() game.SpawnRules {
+	return game.SpawnRules{
+		TTL:              c.SpawnTTL,
+		ActiveWindow:     c.SpawnActiveWindow,
+		PlayerRadius:     c.SpawnRadiusMeters,
+		PlayerMaxActive:  int32(c.SpawnMaxNearPlayer),
+		MinSpacingMeters: c.MinEggSpacingMeters,
+	}
+}
+
+// AntiCheatRules returns the configured thresholds of the location anti-cheat.
+func (c *Config) AntiCheatRules() game.AntiCheatRules {
+	return game.AntiCheatRules{
+		MaxSpeed:    c.AntiCheatMaxSpeed,
+		MinAccuracy: c.AntiCheatMinAccuracy,
+		DelayScore:  int(c.AntiCheatDelayScore),
+		RejectScore: int(c.AntiCheatRejectScore),
+		Hold:        c.AntiCheatHold,
+	}
+}
+
 // parseDuration pulls an env var and parses it into a time.Duration.
 func parseDuration(envKey string) (time.Duration, error) {
 	val := os.Getenv(envKey)
@@ -93,6 +303,68 @@ func parseDuration(envKey string) (time.Duration, error) {
 	return dur, nil
 }
 
+// parseDurationOr pulls an optional env var and parses it into a time.Duration,
+// falling back to def when it is unset.
+func parseDurationOr(envKey string, def time.Duration) (time.Duration, error) {
+	if os.Getenv(envKey) == "" {
+		return def, nil
+	}
+	return parseDuration(envKey)
+}
+
+// parseFloatOr pulls an optional env var and parses it into a float64,
+// falling back to def when it is unset.
+func parseFloatOr(envKey string, def float64) (float64, error) {
+	val := os.Getenv(envKey)
+	if val == "" {
+		return def, nil
+	}
+	f, err := strconv.ParseFloat(val, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number for %s: %w", envKey, err)
+	}
+	return f, nil
+}
+
+// parseIntOr pulls an optional env var and parses it into an int64,
+// falling back to def when it is unset.
+func parseIntOr(envKey string, def int64) (int64, error) {
+	val := os.Getenv(envKey)
+	if val == "" {
+		return def, nil
+	}
+	n, err := strconv.ParseInt(val, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid integer for %s: %w", envKey, err)
+	}
+	return n, nil
+}
+
+// getenvOr pulls an optional env var, falling back to def when it is unset.
+func getenvOr(envKey, def string) string {
+	if val := os.Getenv(envKey); val != "" {
+		return val
+	}
+	return def
+}
+
+// parseListOr pulls an optional comma separated env var,
+// falling back to def when it is unset.
+func parseListOr(envKey string, def []string) []string {
+	val := os.Getenv(envKey)
+	if val == "" {
+		return def
+	}
+
+	var items []string
+	for _, item := range strings.Split(val, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
 // validateConfig checks that all required environment variables are set.
 func validateConfig(config *Config) error {
 	if config.DbUrl == "" {
@@ -107,6 +379,27 @@ func validateConfig(config *Config) error {
 	if config.ResendApiKey == "" {
 		return errors.New("missing required environment variable: RESEND_API_KEY")
 	}
+	if config.XPCurveBase <= 0 || config.XPCurveExponent <= 0 || config.MaxLevel < 1 {
+		return errors.New("invalid XP curve: XP_CURVE_BASE and XP_CURVE_EXPONENT must be positive and MAX_LEVEL at least 1")
+	}
+	if config.SpawnInterval <= 0 || config.SpawnTTL <= 0 || config.SpawnRadiusMeters <= 0 {
+		return errors.New("invalid spawner settings: SPAWN_INTERVAL, SPAWN_TTL and SPAWN_RADIUS_METERS must be positive")
+	}
+	if config.LocationMaxAge <= 0 || config.LocationRetention < config.LocationMaxAge || config.LocationPruneInterval <= 0 {
+		return errors.New("invalid location settings: LOCATION_MAX_AGE and LOCATION_PRUNE_INTERVAL must be positive and LOCATION_RETENTION at least LOCATION_MAX_AGE")
+	}
+	if config.AntiCheatDelayScore < 0 || config.AntiCheatRejectScore < config.AntiCheatDelayScore {
+		return errors.New("invalid anti-cheat settings: ANTICHEAT_REJECT_SCORE must be at least ANTICHEAT_DELAY_SCORE")
+	}
+	if config.HintSalt == "" {
+		return errors.New("missing required environment variable: HINT_SALT")
+	}
+	if config.TileCacheTTL < 0 {
+		return errors.New("invalid TILE_CACHE_TTL: must not be negative")
+	}
+	if config.LeaderboardFlushInterval <= 0 {
+		return errors.New("invalid LEADERBOARD_FLUSH_INTERVAL: must be positive")
+	}
 	if config.Port == "" {
 		return errors.New("missing required environment variable: PORT")
 	}
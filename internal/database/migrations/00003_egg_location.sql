@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Where the egg was dropped on the map
+ALTER TABLE eggs ADD COLUMN IF NOT EXISTS location geometry(Point, 4326);
+
+-- Spatial indexes for bounding box (geometry) and radius (geography) searches
+CREATE INDEX IF NOT EXISTS idx_eggs_location ON eggs USING GIST (location);
+CREATE INDEX IF NOT EXISTS idx_eggs_location_geog ON eggs USING GIST ((location::geography));
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_eggs_location_geog;
+DROP INDEX IF EXISTS idx_eggs_location;
+ALTER TABLE eggs DROP COLUMN IF EXISTS location;
+-- +goose StatementEnd
@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Lifecycle: PLANTED -> INCUBATING -> READY -> HATCHED, or READY -> DECAYED
+ALTER TABLE eggs
+  ADD COLUMN state VARCHAR(20) NOT NULL DEFAULT 'PLANTED'
+    CHECK (state IN ('PLANTED', 'INCUBATING', 'READY', 'HATCHED', 'DECAYED')),
+  ADD COLUMN planted_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  ADD COLUMN incubates_at TIMESTAMPTZ NOT NULL DEFAULT now(), -- scheduled PLANTED -> INCUBATING
+  ADD COLUMN ready_at TIMESTAMPTZ NOT NULL DEFAULT now(),     -- scheduled INCUBATING -> READY
+  ADD COLUMN decays_at TIMESTAMPTZ NOT NULL DEFAULT now();    -- scheduled READY -> DECAYED
+
+UPDATE eggs SET state = 'HATCHED' WHERE hatched;
+
+CREATE INDEX idx_eggs_state ON eggs (state);
+
+-- Audit trail of every state change
+CREATE TABLE egg_transitions (
+  id BIGSERIAL PRIMARY KEY,
+  inventory_id UUID NOT NULL REFERENCES eggs(inventory_id) ON DELETE CASCADE,
+  from_state VARCHAR(20) NOT NULL,
+  to_state VARCHAR(20) NOT NULL,
+  transitioned_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_egg_transitions_inventory_id ON egg_transitions (inventory_id);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS egg_transitions;
+DROP INDEX IF EXISTS idx_eggs_state;
+ALTER TABLE eggs
+  DROP COLUMN IF EXISTS decays_at,
+  DROP COLUMN IF EXISTS ready_at,
+  DROP COLUMN IF EXISTS incubates_at,
+  DROP COLUMN IF EXISTS planted_at,
+  DROP COLUMN IF EXISTS state;
+-- +goose StatementEnd
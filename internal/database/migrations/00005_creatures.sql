@@ -0,0 +1,55 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Species that can come out of an egg, rolled by weight per egg type
+CREATE TABLE species (
+  id SERIAL PRIMARY KEY,
+  code VARCHAR(40) UNIQUE NOT NULL,
+  name VARCHAR NOT NULL,
+  egg_type VARCHAR(20) NOT NULL, -- BUNNY, GOLDEN, LEGENDARY
+  rarity VARCHAR(20) NOT NULL DEFAULT 'COMMON' CHECK (rarity IN ('COMMON', 'RARE', 'EPIC', 'LEGENDARY')),
+  weight INT NOT NULL CHECK (weight > 0),
+  description TEXT,
+  created_at TIMESTAMPTZ DEFAULT now()
+);
+
+CREATE INDEX idx_species_egg_type ON species (egg_type);
+
+INSERT INTO species (code, name, egg_type, rarity, weight, description) VALUES
+  ('COTTONTAIL', 'Cottontail', 'BUNNY', 'COMMON', 60, 'A fluffy bunny that loves clover.'),
+  ('LOP', 'Lop', 'BUNNY', 'COMMON', 30, 'Its floppy ears make it a great listener.'),
+  ('MOON_BUNNY', 'Moon Bunny', 'BUNNY', 'RARE', 10, 'Only hops around at night.'),
+  ('GOLDEN_HEN', 'Golden Hen', 'GOLDEN', 'RARE', 50, 'Lays eggs that shine in the sun.'),
+  ('GILDED_FINCH', 'Gilded Finch', 'GOLDEN', 'RARE', 35, 'Sings a song worth its weight in gold.'),
+  ('SUN_PHOENIX', 'Sun Phoenix', 'GOLDEN', 'EPIC', 15, 'Born from the first light of morning.'),
+  ('EMBER_DRAGON', 'Ember Dragon', 'LEGENDARY', 'EPIC', 50, 'A young dragon with a warm heart.'),
+  ('STORM_GRIFFIN', 'Storm Griffin', 'LEGENDARY', 'EPIC', 35, 'Rides the wind ahead of the thunder.'),
+  ('ELDER_WYRM', 'Elder Wyrm', 'LEGENDARY', 'LEGENDARY', 15, 'Older than the map itself.');
+
+-- Creatures join a player's collection as a new inventory item type
+ALTER TABLE inventory DROP CONSTRAINT IF EXISTS inventory_item_type_check;
+ALTER TABLE inventory ADD CONSTRAINT inventory_item_type_check
+  CHECK (item_type IN ('EGG', 'TOOL', 'BOOST', 'CREATURE'));
+
+-- Creatures as an inventory subtype
+CREATE TABLE creatures (
+  inventory_id UUID PRIMARY KEY REFERENCES inventory(id) ON DELETE CASCADE,
+  species_id INT NOT NULL REFERENCES species(id),
+  egg_id UUID UNIQUE REFERENCES eggs(inventory_id) ON DELETE SET NULL, -- the egg it hatched from
+  nickname TEXT,
+  hatched_at TIMESTAMPTZ DEFAULT now()
+);
+
+CREATE INDEX idx_creatures_species_id ON creatures (species_id);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS creatures;
+DELETE FROM inventory WHERE item_type = 'CREATURE';
+ALTER TABLE inventory DROP CONSTRAINT IF EXISTS inventory_item_type_check;
+ALTER TABLE inventory ADD CONSTRAINT inventory_item_type_check
+  CHECK (item_type IN ('EGG', 'TOOL', 'BOOST'));
+DROP TABLE IF EXISTS species;
+-- +goose StatementEnd
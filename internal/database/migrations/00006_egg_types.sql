@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Catalog of egg types players can drop
+CREATE TABLE egg_types (
+  code VARCHAR(20) PRIMARY KEY, -- e.g., BUNNY, GOLDEN, LEGENDARY, DRAGON
+  name VARCHAR NOT NULL,
+  rarity VARCHAR(20) NOT NULL DEFAULT 'COMMON' CHECK (rarity IN ('COMMON', 'RARE', 'EPIC', 'LEGENDARY')),
+  coin_cost BIGINT NOT NULL DEFAULT 0 CHECK (coin_cost >= 0),
+  incubation_seconds INT NOT NULL CHECK (incubation_seconds >= 0),
+  decay_seconds INT NOT NULL CHECK (decay_seconds > 0),
+  min_level INT NOT NULL DEFAULT 1,
+  hatch_xp BIGINT NOT NULL DEFAULT 0,
+  hatch_coins BIGINT NOT NULL DEFAULT 0,
+  enabled BOOLEAN NOT NULL DEFAULT true,
+  created_at TIMESTAMPTZ DEFAULT now(),
+  updated_at TIMESTAMPTZ DEFAULT now()
+);
+
+INSERT INTO egg_types (code, name, rarity, coin_cost, incubation_seconds, decay_seconds, min_level, hatch_xp, hatch_coins) VALUES
+  ('BUNNY', 'Bunny Egg', 'COMMON', 0, 1800, 86400, 1, 50, 10),
+  ('GOLDEN', 'Golden Egg', 'RARE', 25, 7200, 43200, 3, 150, 50),
+  ('DRAGON', 'Dragon Egg', 'EPIC', 60, 14400, 28800, 5, 300, 120),
+  ('LEGENDARY', 'Legendary Egg', 'LEGENDARY', 100, 21600, 21600, 10, 500, 200);
+
+INSERT INTO species (code, name, egg_type, rarity, weight, description) VALUES
+  ('CINDER_DRAKE', 'Cinder Drake', 'DRAGON', 'RARE', 70, 'Its sneezes leave little scorch marks.'),
+  ('JADE_DRAGON', 'Jade Dragon', 'DRAGON', 'EPIC', 30, 'Guards the greenest corners of the park.');
+
+ALTER TABLE eggs ADD CONSTRAINT eggs_type_fkey FOREIGN KEY (type) REFERENCES egg_types (code);
+ALTER TABLE species ADD CONSTRAINT species_egg_type_fkey FOREIGN KEY (egg_type) REFERENCES egg_types (code);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE species DROP CONSTRAINT IF EXISTS species_egg_type_fkey;
+ALTER TABLE eggs DROP CONSTRAINT IF EXISTS eggs_type_fkey;
+DELETE FROM species WHERE egg_type = 'DRAGON';
+DROP TABLE IF EXISTS egg_types;
+-- +goose StatementEnd
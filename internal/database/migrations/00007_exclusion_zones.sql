@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Areas where eggs may not be dropped or spawned (schools, highways, private property)
+CREATE TABLE exclusion_zones (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  name VARCHAR NOT NULL,
+  description TEXT NOT NULL DEFAULT '',
+  area geometry(MultiPolygon, 4326) NOT NULL CHECK (ST_IsValid(area)),
+  created_by UUID REFERENCES accounts(id) ON DELETE SET NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_exclusion_zones_area ON exclusion_zones USING GIST (area);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS exclusion_zones;
+-- +goose StatementEnd
@@ -0,0 +1,46 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Tools get a kind and the slot they are equipped in
+ALTER TABLE tools ADD COLUMN kind VARCHAR(20);
+ALTER TABLE tools ADD COLUMN slot VARCHAR(20);
+
+UPDATE tools t
+SET kind = CASE upper(i.description)
+    WHEN 'SHOVEL' THEN 'SHOVEL'
+    WHEN 'SHIELD' THEN 'SHIELD'
+    ELSE 'RADAR'
+  END
+FROM inventory i
+WHERE i.id = t.inventory_id;
+
+UPDATE tools
+SET slot = CASE kind
+    WHEN 'RADAR' THEN 'DEVICE'
+    WHEN 'SHOVEL' THEN 'HAND'
+    WHEN 'SHIELD' THEN 'BODY'
+  END;
+
+ALTER TABLE tools
+  ALTER COLUMN kind SET NOT NULL,
+  ALTER COLUMN slot SET NOT NULL,
+  ADD CONSTRAINT tools_kind_check CHECK (kind IN ('RADAR', 'SHOVEL', 'SHIELD')),
+  ADD CONSTRAINT tools_slot_check CHECK (slot IN ('DEVICE', 'HAND', 'BODY')),
+  ADD CONSTRAINT tools_durability_check CHECK (durability >= 0);
+
+UPDATE tools SET equipped = false WHERE equipped IS NULL;
+ALTER TABLE tools ALTER COLUMN equipped SET NOT NULL;
+
+-- Eggs dropped while a shield is equipped cannot be taken by other players
+ALTER TABLE eggs ADD COLUMN shielded BOOLEAN NOT NULL DEFAULT false;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE eggs DROP COLUMN IF EXISTS shielded;
+ALTER TABLE tools ALTER COLUMN equipped DROP NOT NULL;
+ALTER TABLE tools DROP CONSTRAINT IF EXISTS tools_durability_check;
+ALTER TABLE tools DROP COLUMN IF EXISTS slot;
+ALTER TABLE tools DROP COLUMN IF EXISTS kind;
+-- +goose StatementEnd
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Boosts as an inventory subtype, one row per stack of identical boosts
+CREATE TABLE boosts (
+  inventory_id UUID PRIMARY KEY REFERENCES inventory(id) ON DELETE CASCADE,
+  kind VARCHAR(20) NOT NULL CHECK (kind IN ('XP_MULTIPLIER', 'INCUBATION_SPEED', 'DISCOVERY_RADIUS')),
+  multiplier DOUBLE PRECISION NOT NULL CHECK (multiplier > 0),
+  duration_seconds INT NOT NULL CHECK (duration_seconds > 0)
+);
+
+-- Boosts a player has activated, applied to game actions until they expire
+CREATE TABLE active_boosts (
+  id BIGSERIAL PRIMARY KEY,
+  player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+  kind VARCHAR(20) NOT NULL CHECK (kind IN ('XP_MULTIPLIER', 'INCUBATION_SPEED', 'DISCOVERY_RADIUS')),
+  multiplier DOUBLE PRECISION NOT NULL,
+  activated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_active_boosts_player ON active_boosts (player_id, expires_at);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS active_boosts;
+DROP TABLE IF EXISTS boosts;
+-- +goose StatementEnd
@@ -0,0 +1,69 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Egg types that must be unlocked (bought or earned) before a player can drop them
+ALTER TABLE egg_types ADD COLUMN requires_unlock BOOLEAN NOT NULL DEFAULT false;
+UPDATE egg_types SET requires_unlock = true WHERE code = 'LEGENDARY';
+
+CREATE TABLE player_egg_types (
+  player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+  egg_type VARCHAR(20) NOT NULL REFERENCES egg_types(code),
+  unlocked_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (player_id, egg_type)
+);
+
+-- What the shop sells. Each purchase of an item grants quantity units.
+CREATE TABLE shop_items (
+  id SERIAL PRIMARY KEY,
+  code VARCHAR(40) UNIQUE NOT NULL,
+  name VARCHAR NOT NULL,
+  description TEXT NOT NULL DEFAULT '',
+  item_type VARCHAR(20) NOT NULL CHECK (item_type IN ('TOOL', 'BOOST', 'EGG_TYPE')),
+  price BIGINT NOT NULL CHECK (price >= 0),
+  quantity INT NOT NULL DEFAULT 1 CHECK (quantity > 0),
+  min_level INT NOT NULL DEFAULT 1,
+  tool_kind VARCHAR(20) CHECK (tool_kind IN ('RADAR', 'SHOVEL', 'SHIELD')),
+  boost_kind VARCHAR(20) CHECK (boost_kind IN ('XP_MULTIPLIER', 'INCUBATION_SPEED', 'DISCOVERY_RADIUS')),
+  boost_multiplier DOUBLE PRECISION CHECK (boost_multiplier > 0),
+  boost_duration_seconds INT CHECK (boost_duration_seconds > 0),
+  egg_type VARCHAR(20) REFERENCES egg_types(code),
+  enabled BOOLEAN NOT NULL DEFAULT true,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CHECK (
+    (item_type = 'TOOL' AND tool_kind IS NOT NULL) OR
+    (item_type = 'BOOST' AND boost_kind IS NOT NULL AND boost_multiplier IS NOT NULL AND boost_duration_seconds IS NOT NULL) OR
+    (item_type = 'EGG_TYPE' AND egg_type IS NOT NULL AND quantity = 1)
+  )
+);
+
+INSERT INTO shop_items (code, name, description, item_type, price, quantity, min_level, tool_kind, boost_kind, boost_multiplier, boost_duration_seconds, egg_type) VALUES
+  ('RADAR', 'Radar', 'Search for eggs twice as far away.', 'TOOL', 40, 1, 1, 'RADAR', NULL, NULL, NULL, NULL),
+  ('SHOVEL', 'Shovel', 'Hatch eggs from a little further away.', 'TOOL', 30, 1, 1, 'SHOVEL', NULL, NULL, NULL, NULL),
+  ('SHIELD', 'Shield', 'Keep other players away from the eggs you drop.', 'TOOL', 60, 1, 2, 'SHIELD', NULL, NULL, NULL, NULL),
+  ('DOUBLE_XP', 'Double XP', 'Double hatch XP for 30 minutes.', 'BOOST', 50, 1, 1, NULL, 'XP_MULTIPLIER', 2.0, 1800, NULL),
+  ('QUICK_INCUBATION', 'Quick Incubation', 'Eggs dropped in the next hour incubate twice as fast.', 'BOOST', 40, 1, 1, NULL, 'INCUBATION_SPEED', 2.0, 3600, NULL),
+  ('WIDE_DISCOVERY', 'Wide Discovery', 'Search 50% further for 30 minutes.', 'BOOST', 30, 1, 1, NULL, 'DISCOVERY_RADIUS', 1.5, 1800, NULL),
+  ('LEGENDARY_LICENSE', 'Legendary Egg License', 'Unlocks dropping Legendary eggs.', 'EGG_TYPE', 500, 1, 10, NULL, NULL, NULL, NULL, 'LEGENDARY');
+
+-- Completed purchases, keyed by the client's request key so retries are not charged twice
+CREATE TABLE purchases (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+  shop_item_id INT NOT NULL REFERENCES shop_items(id),
+  request_key VARCHAR(100) NOT NULL,
+  packs INT NOT NULL CHECK (packs > 0),
+  price BIGINT NOT NULL, -- total charged
+  inventory_ids UUID[] NOT NULL DEFAULT '{}',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  UNIQUE (player_id, request_key)
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS purchases;
+DROP TABLE IF EXISTS shop_items;
+DROP TABLE IF EXISTS player_egg_types;
+ALTER TABLE egg_types DROP COLUMN IF EXISTS requires_unlock;
+-- +goose StatementEnd
@@ -0,0 +1,51 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Double-entry ledger of coin and XP movements. Every transaction has one
+-- leg per account involved and its legs sum to zero per currency. A player's
+-- balance is the sum of the legs on its 'player:<id>' account.
+CREATE TABLE ledger_entries (
+  id BIGSERIAL PRIMARY KEY,
+  transaction_id UUID NOT NULL,
+  account VARCHAR(80) NOT NULL, -- 'player:<id>' or 'system:<reason>'
+  player_id UUID REFERENCES players(id) ON DELETE CASCADE, -- set on player legs
+  currency VARCHAR(10) NOT NULL CHECK (currency IN ('COIN', 'XP')),
+  amount BIGINT NOT NULL CHECK (amount <> 0),
+  reason VARCHAR(40) NOT NULL, -- e.g., HATCH_REWARD, EGG_DROP, SHOP_PURCHASE
+  reference_type VARCHAR(40) NOT NULL DEFAULT '', -- entity the movement is about, e.g., egg
+  reference_id VARCHAR(64) NOT NULL DEFAULT '',
+  idempotency_key VARCHAR(200) NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  UNIQUE (idempotency_key, account, currency)
+);
+
+CREATE INDEX idx_ledger_entries_player ON ledger_entries (player_id, id) WHERE player_id IS NOT NULL;
+CREATE INDEX idx_ledger_entries_transaction ON ledger_entries (transaction_id);
+
+-- Opening balances, so the ledger explains what players already hold
+INSERT INTO ledger_entries (transaction_id, account, player_id, currency, amount, reason, reference_type, reference_id, idempotency_key)
+SELECT
+  md5('opening:' || p.id || ':' || c.currency)::uuid,
+  leg.account,
+  leg.player_id,
+  c.currency,
+  leg.amount,
+  'OPENING_BALANCE',
+  'player',
+  p.id::text,
+  'opening:' || p.id
+FROM players p
+CROSS JOIN LATERAL (VALUES ('COIN', p.coins), ('XP', p.xp)) AS c(currency, balance)
+CROSS JOIN LATERAL (
+  VALUES
+    ('player:' || p.id, p.id, c.balance),
+    ('system:opening_balance', NULL::uuid, -c.balance)
+) AS leg(account, player_id, amount)
+WHERE c.balance <> 0;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS ledger_entries;
+-- +goose StatementEnd
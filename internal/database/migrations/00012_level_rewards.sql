@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- What a player is granted on reaching a level. The XP each level takes is
+-- configured on the server (XP_CURVE_BASE, XP_CURVE_EXPONENT, MAX_LEVEL).
+CREATE TABLE level_rewards (
+  level INT PRIMARY KEY CHECK (level > 1),
+  coins BIGINT NOT NULL DEFAULT 0 CHECK (coins >= 0),
+  shop_item_code VARCHAR(40) REFERENCES shop_items(code), -- item granted as if bought, if any
+  packs INT NOT NULL DEFAULT 1 CHECK (packs > 0),
+  egg_type VARCHAR(20) REFERENCES egg_types(code), -- egg type unlocked, if any
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+INSERT INTO level_rewards (level, coins, shop_item_code, packs, egg_type) VALUES
+  (2, 50, NULL, 1, NULL),
+  (3, 75, 'SHOVEL', 1, NULL),
+  (5, 150, 'DOUBLE_XP', 1, NULL),
+  (8, 200, 'SHIELD', 1, NULL),
+  (10, 300, 'WIDE_DISCOVERY', 2, NULL),
+  (15, 500, NULL, 1, 'LEGENDARY');
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS level_rewards;
+-- +goose StatementEnd
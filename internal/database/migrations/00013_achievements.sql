@@ -0,0 +1,49 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Long-term goals. Each achievement follows one game event: COUNT
+-- achievements count the events, MAX achievements keep the largest value an
+-- event carried (e.g., meters between two dropped eggs).
+CREATE TABLE achievements (
+  code VARCHAR(40) PRIMARY KEY,
+  name VARCHAR(100) NOT NULL,
+  description TEXT NOT NULL DEFAULT '',
+  event VARCHAR(40) NOT NULL, -- e.g., EGG_DROPPED, EGG_HATCHED
+  egg_type VARCHAR(20) REFERENCES egg_types(code), -- only events about this egg type count, if set
+  metric VARCHAR(10) NOT NULL DEFAULT 'COUNT' CHECK (metric IN ('COUNT', 'MAX')),
+  target BIGINT NOT NULL CHECK (target > 0),
+  reward_coins BIGINT NOT NULL DEFAULT 0 CHECK (reward_coins >= 0),
+  reward_xp BIGINT NOT NULL DEFAULT 0 CHECK (reward_xp >= 0),
+  sort_order INT NOT NULL DEFAULT 0,
+  enabled BOOLEAN NOT NULL DEFAULT true,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_achievements_event ON achievements (event) WHERE enabled;
+
+INSERT INTO achievements (code, name, description, event, egg_type, metric, target, reward_coins, reward_xp, sort_order) VALUES
+  ('FIRST_HATCH', 'First Hatch', 'Hatch your first egg.', 'EGG_HATCHED', NULL, 'COUNT', 1, 20, 10, 10),
+  ('HATCH_10', 'Hatchery', 'Hatch 10 eggs.', 'EGG_HATCHED', NULL, 'COUNT', 10, 100, 100, 20),
+  ('HATCH_100', 'Master Breeder', 'Hatch 100 eggs.', 'EGG_HATCHED', NULL, 'COUNT', 100, 500, 500, 30),
+  ('DROP_10', 'Egg Layer', 'Drop 10 eggs.', 'EGG_DROPPED', NULL, 'COUNT', 10, 50, 50, 40),
+  ('DROP_5KM', 'Globetrotter', 'Drop two eggs 5 km apart.', 'EGG_DROPPED', NULL, 'MAX', 5000, 150, 100, 50),
+  ('LEGENDARY_HATCH', 'Legend Keeper', 'Collect a creature from a Legendary egg.', 'EGG_HATCHED', 'LEGENDARY', 'COUNT', 1, 300, 300, 60);
+
+-- Progress of every player towards the achievements they have an event for
+CREATE TABLE player_achievements (
+  player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+  achievement_code VARCHAR(40) NOT NULL REFERENCES achievements(code),
+  progress BIGINT NOT NULL DEFAULT 0,
+  unlocked_at TIMESTAMPTZ, -- set once progress reaches the target
+  claimed_at TIMESTAMPTZ, -- set once the reward was paid
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (player_id, achievement_code)
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS player_achievements;
+DROP TABLE IF EXISTS achievements;
+-- +goose StatementEnd
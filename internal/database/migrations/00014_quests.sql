@@ -0,0 +1,54 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Pool of quests players are handed each day or week. Every player gets a
+-- few templates of each period picked by weight, the same for the whole
+-- period. Templates follow game events like achievements do.
+CREATE TABLE quest_templates (
+  code VARCHAR(40) PRIMARY KEY,
+  name VARCHAR(100) NOT NULL,
+  description TEXT NOT NULL DEFAULT '',
+  period VARCHAR(10) NOT NULL CHECK (period IN ('DAILY', 'WEEKLY')),
+  event VARCHAR(40) NOT NULL, -- e.g., EGG_DROPPED, EGG_HATCHED
+  egg_type VARCHAR(20) REFERENCES egg_types(code), -- only events about this egg type count, if set
+  metric VARCHAR(10) NOT NULL DEFAULT 'COUNT' CHECK (metric IN ('COUNT', 'MAX')),
+  target BIGINT NOT NULL CHECK (target > 0),
+  reward_coins BIGINT NOT NULL DEFAULT 0 CHECK (reward_coins >= 0),
+  reward_xp BIGINT NOT NULL DEFAULT 0 CHECK (reward_xp >= 0),
+  weight INT NOT NULL DEFAULT 1 CHECK (weight >= 0), -- how often the template is picked
+  enabled BOOLEAN NOT NULL DEFAULT true,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+INSERT INTO quest_templates (code, name, description, period, event, egg_type, metric, target, reward_coins, reward_xp, weight) VALUES
+  ('DAILY_HATCH_1', 'Fresh Hatch', 'Hatch an egg.', 'DAILY', 'EGG_HATCHED', NULL, 'COUNT', 1, 20, 20, 4),
+  ('DAILY_HATCH_3', 'Busy Nest', 'Hatch 3 eggs.', 'DAILY', 'EGG_HATCHED', NULL, 'COUNT', 3, 50, 50, 3),
+  ('DAILY_DROP_3', 'Egg Hunt Host', 'Drop 3 eggs.', 'DAILY', 'EGG_DROPPED', NULL, 'COUNT', 3, 30, 20, 4),
+  ('DAILY_DROP_DRAGON', 'Dragon Keeper', 'Drop a Dragon egg.', 'DAILY', 'EGG_DROPPED', 'DRAGON', 'COUNT', 1, 60, 30, 1),
+  ('DAILY_HATCH_GOLDEN', 'Golden Touch', 'Hatch a Golden egg.', 'DAILY', 'EGG_HATCHED', 'GOLDEN', 'COUNT', 1, 50, 50, 2),
+  ('DAILY_DROP_1KM', 'Wanderer', 'Drop an egg 1 km from another of yours.', 'DAILY', 'EGG_DROPPED', NULL, 'MAX', 1000, 40, 30, 2),
+  ('WEEKLY_HATCH_15', 'Nursery', 'Hatch 15 eggs.', 'WEEKLY', 'EGG_HATCHED', NULL, 'COUNT', 15, 150, 200, 3),
+  ('WEEKLY_DROP_20', 'Egg Scatterer', 'Drop 20 eggs.', 'WEEKLY', 'EGG_DROPPED', NULL, 'COUNT', 20, 100, 100, 3),
+  ('WEEKLY_HATCH_DRAGON', 'Dragon Tamer', 'Hatch a Dragon egg.', 'WEEKLY', 'EGG_HATCHED', 'DRAGON', 'COUNT', 1, 200, 250, 1),
+  ('WEEKLY_DROP_10KM', 'Voyager', 'Drop an egg 10 km from another of yours.', 'WEEKLY', 'EGG_DROPPED', NULL, 'MAX', 10000, 150, 150, 2);
+
+-- Progress of a player on a quest in one period, keyed by the local date
+-- the period started on
+CREATE TABLE player_quests (
+  player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+  template_code VARCHAR(40) NOT NULL REFERENCES quest_templates(code),
+  period_start DATE NOT NULL,
+  progress BIGINT NOT NULL DEFAULT 0,
+  completed_at TIMESTAMPTZ,
+  claimed_at TIMESTAMPTZ,
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (player_id, template_code, period_start)
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS player_quests;
+DROP TABLE IF EXISTS quest_templates;
+-- +goose StatementEnd
@@ -0,0 +1,77 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Consecutive days a player was active on, counted in their time zone
+CREATE TABLE login_streaks (
+  player_id UUID PRIMARY KEY REFERENCES players(id) ON DELETE CASCADE,
+  current INT NOT NULL DEFAULT 0,
+  longest INT NOT NULL DEFAULT 0,
+  last_active_on DATE, -- local date of the last day with activity
+  freezes INT NOT NULL DEFAULT 0 CHECK (freezes >= 0), -- missed days the streak survives
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- Rewards calendar, claimed once a day. The streak day picks the reward and
+-- the calendar repeats after day 7.
+CREATE TABLE daily_rewards (
+  day INT PRIMARY KEY CHECK (day BETWEEN 1 AND 7),
+  coins BIGINT NOT NULL DEFAULT 0 CHECK (coins >= 0),
+  xp BIGINT NOT NULL DEFAULT 0 CHECK (xp >= 0),
+  shop_item_code VARCHAR(40) REFERENCES shop_items(code), -- item granted as if bought, if any
+  packs INT NOT NULL DEFAULT 1 CHECK (packs > 0),
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE daily_reward_claims (
+  player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+  claimed_on DATE NOT NULL, -- local date of the claim
+  day INT NOT NULL, -- calendar day claimed
+  streak INT NOT NULL, -- streak at the time of the claim
+  claimed_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (player_id, claimed_on)
+);
+
+-- Streak freezes are sold in the shop and kept as a count on the streak
+ALTER TABLE shop_items DROP CONSTRAINT IF EXISTS shop_items_item_type_check;
+ALTER TABLE shop_items ADD CONSTRAINT shop_items_item_type_check
+  CHECK (item_type IN ('TOOL', 'BOOST', 'EGG_TYPE', 'STREAK_FREEZE'));
+ALTER TABLE shop_items DROP CONSTRAINT IF EXISTS shop_items_check;
+ALTER TABLE shop_items ADD CONSTRAINT shop_items_check CHECK (
+  (item_type = 'TOOL' AND tool_kind IS NOT NULL) OR
+  (item_type = 'BOOST' AND boost_kind IS NOT NULL AND boost_multiplier IS NOT NULL AND boost_duration_seconds IS NOT NULL) OR
+  (item_type = 'EGG_TYPE' AND egg_type IS NOT NULL AND quantity = 1) OR
+  (item_type = 'STREAK_FREEZE')
+);
+
+INSERT INTO shop_items (code, name, description, item_type, price, quantity, min_level) VALUES
+  ('STREAK_FREEZE', 'Streak Freeze', 'Keeps your login streak going through a day you miss.', 'STREAK_FREEZE', 80, 1, 1);
+
+INSERT INTO daily_rewards (day, coins, xp, shop_item_code, packs) VALUES
+  (1, 20, 10, NULL, 1),
+  (2, 30, 15, NULL, 1),
+  (3, 40, 20, NULL, 1),
+  (4, 50, 25, NULL, 1),
+  (5, 60, 30, 'WIDE_DISCOVERY', 1),
+  (6, 80, 40, NULL, 1),
+  (7, 150, 75, 'STREAK_FREEZE', 1);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS daily_reward_claims;
+DROP TABLE IF EXISTS daily_rewards;
+DROP TABLE IF EXISTS login_streaks;
+
+DELETE FROM purchases WHERE shop_item_id IN (SELECT id FROM shop_items WHERE item_type = 'STREAK_FREEZE');
+DELETE FROM shop_items WHERE item_type = 'STREAK_FREEZE';
+ALTER TABLE shop_items DROP CONSTRAINT IF EXISTS shop_items_check;
+ALTER TABLE shop_items ADD CONSTRAINT shop_items_check CHECK (
+  (item_type = 'TOOL' AND tool_kind IS NOT NULL) OR
+  (item_type = 'BOOST' AND boost_kind IS NOT NULL AND boost_multiplier IS NOT NULL AND boost_duration_seconds IS NOT NULL) OR
+  (item_type = 'EGG_TYPE' AND egg_type IS NOT NULL AND quantity = 1)
+);
+ALTER TABLE shop_items DROP CONSTRAINT IF EXISTS shop_items_item_type_check;
+ALTER TABLE shop_items ADD CONSTRAINT shop_items_item_type_check
+  CHECK (item_type IN ('TOOL', 'BOOST', 'EGG_TYPE'));
+-- +goose StatementEnd
@@ -0,0 +1,48 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- How often the spawner picks each egg type, 0 never spawns it
+ALTER TABLE egg_types ADD COLUMN spawn_weight INT NOT NULL DEFAULT 0 CHECK (spawn_weight >= 0);
+UPDATE egg_types SET spawn_weight = CASE code
+  WHEN 'BUNNY' THEN 60
+  WHEN 'GOLDEN' THEN 25
+  WHEN 'DRAGON' THEN 10
+  WHEN 'LEGENDARY' THEN 5
+  ELSE 0
+END;
+
+-- Places the spawner keeps stocked with eggs, e.g., parks and squares
+CREATE TABLE spawn_points (
+  id SERIAL PRIMARY KEY,
+  name VARCHAR NOT NULL,
+  location geometry(Point, 4326) NOT NULL,
+  radius_meters DOUBLE PRECISION NOT NULL DEFAULT 200 CHECK (radius_meters > 0),
+  max_active INT NOT NULL DEFAULT 5 CHECK (max_active > 0), -- density cap within the radius
+  enabled BOOLEAN NOT NULL DEFAULT true,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- Server-owned eggs placed by the spawner. They belong to no player until
+-- collected and are removed once they expire uncollected.
+CREATE TABLE egg_spawns (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  egg_type VARCHAR(20) NOT NULL REFERENCES egg_types(code),
+  location geometry(Point, 4326) NOT NULL,
+  spawn_point_id INT REFERENCES spawn_points(id) ON DELETE SET NULL, -- NULL for spawns around players
+  spawned_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  expires_at TIMESTAMPTZ NOT NULL,
+  collected_at TIMESTAMPTZ,
+  collected_by UUID REFERENCES players(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_egg_spawns_location_geog ON egg_spawns USING GIST ((location::geography)) WHERE collected_at IS NULL;
+CREATE INDEX idx_egg_spawns_expires_at ON egg_spawns (expires_at) WHERE collected_at IS NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS egg_spawns;
+DROP TABLE IF EXISTS spawn_points;
+ALTER TABLE egg_types DROP COLUMN IF EXISTS spawn_weight;
+-- +goose StatementEnd
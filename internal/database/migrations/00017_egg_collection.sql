@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Who picked the egg up and who originally dropped it. A collected egg moves
+-- to the finder's inventory, so drops are attributed through dropped_by.
+ALTER TABLE eggs
+  ADD COLUMN collected_by UUID REFERENCES players(id) ON DELETE SET NULL,
+  ADD COLUMN dropped_by UUID REFERENCES players(id) ON DELETE SET NULL;
+
+UPDATE eggs e
+SET dropped_by = i.player_id
+FROM inventory i
+WHERE i.id = e.inventory_id;
+
+CREATE INDEX idx_eggs_dropped_by ON eggs (dropped_by, planted_at);
+CREATE INDEX idx_eggs_collected_by ON eggs (collected_by) WHERE collected_by IS NOT NULL;
+
+INSERT INTO achievements (code, name, description, event, egg_type, metric, target, reward_coins, reward_xp, sort_order) VALUES
+  ('FIRST_FIND', 'Finders Keepers', 'Collect an egg dropped by another player.', 'EGG_COLLECTED', NULL, 'COUNT', 1, 20, 10, 70),
+  ('FIND_25', 'Egg Hunter', 'Collect 25 eggs dropped by other players.', 'EGG_COLLECTED', NULL, 'COUNT', 25, 200, 150, 80);
+
+INSERT INTO quest_templates (code, name, description, period, event, egg_type, metric, target, reward_coins, reward_xp, weight) VALUES
+  ('DAILY_FIND_1', 'Scavenger', 'Collect an egg dropped by another player.', 'DAILY', 'EGG_COLLECTED', NULL, 'COUNT', 1, 25, 20, 1);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM player_quests WHERE template_code = 'DAILY_FIND_1';
+DELETE FROM quest_templates WHERE code = 'DAILY_FIND_1';
+DELETE FROM player_achievements WHERE achievement_code IN ('FIRST_FIND', 'FIND_25');
+DELETE FROM achievements WHERE code IN ('FIRST_FIND', 'FIND_25');
+DROP INDEX IF EXISTS idx_eggs_collected_by;
+DROP INDEX IF EXISTS idx_eggs_dropped_by;
+ALTER TABLE eggs DROP COLUMN IF EXISTS dropped_by, DROP COLUMN IF EXISTS collected_by;
+-- +goose StatementEnd
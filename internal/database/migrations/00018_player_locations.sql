@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Positions players report while the app is open. Proximity checks use the
+-- latest one instead of coordinates sent along with each action.
+CREATE TABLE player_locations (
+  id BIGSERIAL PRIMARY KEY,
+  player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+  location geometry(Point, 4326) NOT NULL,
+  accuracy_meters DOUBLE PRECISION CHECK (accuracy_meters >= 0), -- NULL when the client did not report it
+  recorded_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_player_locations_player ON player_locations (player_id, recorded_at DESC);
+CREATE INDEX idx_player_locations_recorded_at ON player_locations (recorded_at);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS player_locations;
+-- +goose StatementEnd
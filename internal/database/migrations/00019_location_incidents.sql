@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Reported locations the anti-cheat found suspicious, kept for review.
+-- hold_until blocks location based actions of delayed players until then,
+-- unless a reviewer dismisses the incident.
+CREATE TABLE location_incidents (
+  id BIGSERIAL PRIMARY KEY,
+  player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+  source VARCHAR(20) NOT NULL CHECK (source IN ('HEARTBEAT', 'DROP')),
+  location geometry(Point, 4326) NOT NULL,
+  accuracy_meters DOUBLE PRECISION, -- NULL when the client did not report it
+  score INT NOT NULL CHECK (score >= 0),
+  action VARCHAR(10) NOT NULL CHECK (action IN ('ALLOW', 'DELAY', 'REJECT')),
+  signals JSONB NOT NULL DEFAULT '[]',
+  hold_until TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  review VARCHAR(10) CHECK (review IN ('CONFIRMED', 'DISMISSED')),
+  review_note TEXT,
+  reviewed_by UUID REFERENCES accounts(id) ON DELETE SET NULL,
+  reviewed_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_location_incidents_player ON location_incidents (player_id, created_at DESC);
+CREATE INDEX idx_location_incidents_open ON location_incidents (created_at DESC) WHERE review IS NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS location_incidents;
+-- +goose StatementEnd
@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- 00004 gave eggs planted before it every timer at the time it ran, a
+-- schedule no egg type produces since decay_seconds is always positive.
+-- Those eggs were planted when their inventory row was created. Unhatched
+-- ones have no schedule to replay, so rather than staying decayed they skip
+-- incubation and get a fresh decay window of their egg type.
+INSERT INTO egg_transitions (inventory_id, from_state, to_state, transitioned_at)
+SELECT inventory_id, state, 'READY', now()
+FROM eggs
+WHERE decays_at = planted_at AND state NOT IN ('HATCHED', 'READY');
+
+UPDATE eggs e
+SET planted_at = legacy.planted_at,
+    incubates_at = CASE WHEN e.state = 'HATCHED' THEN legacy.planted_at ELSE now() END,
+    ready_at = CASE WHEN e.state = 'HATCHED' THEN legacy.planted_at ELSE now() END,
+    decays_at = CASE WHEN e.state = 'HATCHED' THEN legacy.planted_at ELSE now() + make_interval(secs => legacy.decay_seconds) END,
+    state = CASE WHEN e.state = 'HATCHED' THEN 'HATCHED' ELSE 'READY' END
+FROM (
+  SELECT
+    l.inventory_id,
+    COALESCE(i.created_at, l.planted_at) AS planted_at,
+    COALESCE(t.decay_seconds, 86400) AS decay_seconds
+  FROM eggs l
+  JOIN inventory i ON i.id = l.inventory_id
+  LEFT JOIN egg_types t ON t.code = l.type
+  WHERE l.decays_at = l.planted_at
+) legacy
+WHERE legacy.inventory_id = e.inventory_id;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- The bogus timers are not restored, the backfilled ones are kept.
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Players whose leaderboard scores changed since they were last written to
+-- the leaderboard store. Rows are queued in the transaction of the change,
+-- so no committed change is missed, and removed once the scores are written.
+CREATE TABLE leaderboard_updates (
+  player_id UUID PRIMARY KEY REFERENCES players(id) ON DELETE CASCADE,
+  queued_at TIMESTAMPTZ NOT NULL DEFAULT clock_timestamp()
+);
+
+CREATE INDEX idx_leaderboard_updates_queued_at ON leaderboard_updates (queued_at);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS leaderboard_updates;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Meters each player travelled per UTC day, added up as their locations are
+-- reported. The locations themselves are pruned after a while, the distances
+-- are kept for the leaderboards. Counting starts with this migration.
+CREATE TABLE player_distances (
+  player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+  day DATE NOT NULL,
+  meters DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK (meters >= 0),
+  PRIMARY KEY (player_id, day)
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS player_distances;
+-- +goose StatementEnd
@@ -90,3 +90,10 @@ WHERE id = $1;
 SELECT id, first_name, last_name, username, profile_url, email,  role, is_2fa_enabled, is_approved, password
 FROM accounts
 WHERE id = $1;
+
+-- name: ListAccountsWithoutPlayer :many
+SELECT a.*
+FROM accounts a
+LEFT JOIN players p ON p.account_id = a.id
+WHERE p.id IS NULL
+ORDER BY a.created_at;
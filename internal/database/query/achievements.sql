@@ -0,0 +1,61 @@
+-- name: AdvanceAchievement :one
+INSERT INTO player_achievements AS pa (player_id, achievement_code, progress, unlocked_at)
+VALUES (
+  @player_id, @achievement_code, @amount::bigint,
+  CASE WHEN @amount::bigint >= @target::bigint THEN now() END
+)
+ON CONFLICT (player_id, achievement_code) DO UPDATE
+SET progress = CASE WHEN @keep_max::boolean THEN GREATEST(pa.progress, EXCLUDED.progress) ELSE pa.progress + EXCLUDED.progress END,
+    unlocked_at = COALESCE(
+      pa.unlocked_at,
+      CASE WHEN (CASE WHEN @keep_max::boolean THEN GREATEST(pa.progress, EXCLUDED.progress) ELSE pa.progress + EXCLUDED.progress END) >= @target::bigint THEN now() END
+    ),
+    updated_at = now()
+RETURNING *, COALESCE(unlocked_at = now(), false)::boolean AS just_unlocked;
+
+-- name: ClaimAchievement :one
+UPDATE player_achievements
+SET claimed_at = now(),
+    updated_at = now()
+WHERE player_id = $1
+  AND achievement_code = $2
+  AND unlocked_at IS NOT NULL
+  AND claimed_at IS NULL
+RETURNING *;
+
+-- name: GetAchievement :one
+SELECT *
+FROM achievements
+WHERE code = $1;
+
+-- name: GetFarthestDropDistance :one
+SELECT COALESCE(MAX(ST_Distance(
+  e.location::geography,
+  ST_SetSRID(ST_MakePoint(@lon::float, @lat::float), 4326)::geography
+)), 0)::float AS distance
+FROM eggs e
+WHERE e.dropped_by = @player_id::uuid AND e.location IS NOT NULL;
+
+-- name: GetPlayerAchievement :one
+SELECT *
+FROM player_achievements
+WHERE player_id = $1 AND achievement_code = $2;
+
+-- name: ListAchievementsByEvent :many
+SELECT *
+FROM achievements
+WHERE enabled
+  AND event = @event
+  AND (egg_type IS NULL OR egg_type = @egg_type::text)
+ORDER BY sort_order, code;
+
+-- name: ListPlayerAchievements :many
+SELECT
+  a.code, a.name, a.description, a.event, a.egg_type, a.metric, a.target, a.reward_coins, a.reward_xp,
+  COALESCE(pa.progress, 0)::bigint AS progress,
+  pa.unlocked_at,
+  pa.claimed_at
+FROM achievements a
+LEFT JOIN player_achievements pa ON pa.achievement_code = a.code AND pa.player_id = $1
+WHERE a.enabled
+ORDER BY a.sort_order, a.code;
@@ -0,0 +1,68 @@
+-- name: ListPlayerTrail :many
+-- The player's latest locations since a time, newest first, with their
+-- distance to a new location. Unknown accuracies come back as -1.
+SELECT
+  ST_Y(location)::float AS lat,
+  ST_X(location)::float AS lon,
+  COALESCE(accuracy_meters, -1)::float AS accuracy_meters,
+  recorded_at,
+  ST_Distance(location::geography, ST_SetSRID(ST_MakePoint(@lon::float, @lat::float), 4326)::geography)::float AS distance
+FROM player_locations
+WHERE player_id = @player_id AND recorded_at >= @since::timestamptz
+ORDER BY recorded_at DESC
+LIMIT @max_fixes::int;
+
+-- name: CreateLocationIncident :one
+INSERT INTO location_incidents (player_id, source, location, accuracy_meters, score, action, signals, hold_until)
+VALUES (
+  @player_id,
+  @source,
+  ST_SetSRID(ST_MakePoint(@lon::float, @lat::float), 4326),
+  sqlc.narg(accuracy_meters),
+  @score,
+  @action,
+  @signals,
+  @hold_until
+)
+RETURNING id;
+
+-- name: GetLocationHold :one
+-- Until when the player's location based actions are on hold, the epoch
+-- when they are not
+SELECT COALESCE(MAX(hold_until), 'epoch'::timestamptz)::timestamptz AS hold_until
+FROM location_incidents
+WHERE player_id = $1 AND review IS DISTINCT FROM 'DISMISSED';
+
+-- name: ListLocationIncidents :many
+SELECT
+  li.id,
+  li.player_id,
+  a.username,
+  li.source,
+  ST_Y(li.location)::float AS lat,
+  ST_X(li.location)::float AS lon,
+  li.accuracy_meters,
+  li.score,
+  li.action,
+  li.signals,
+  li.hold_until,
+  li.created_at,
+  li.review,
+  li.review_note,
+  li.reviewed_by,
+  li.reviewed_at
+FROM location_incidents li
+JOIN players p ON p.id = li.player_id
+JOIN accounts a ON a.id = p.account_id
+WHERE (sqlc.narg(player_id)::uuid IS NULL OR li.player_id = sqlc.narg(player_id)::uuid)
+  AND (sqlc.narg(reviewed)::boolean IS NULL OR (li.review IS NOT NULL) = sqlc.narg(reviewed)::boolean)
+ORDER BY li.created_at DESC
+LIMIT @page_limit::int OFFSET @page_offset::int;
+
+-- name: ReviewLocationIncident :execrows
+UPDATE location_incidents
+SET review = @review::varchar,
+    review_note = @review_note::text,
+    reviewed_by = @reviewed_by::uuid,
+    reviewed_at = @reviewed_at::timestamptz
+WHERE id = @id;
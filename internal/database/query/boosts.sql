@@ -0,0 +1,34 @@
+-- name: AddBoostDetails :one
+INSERT INTO boosts (inventory_id, kind, multiplier, duration_seconds)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: ConsumeInventoryItem :one
+UPDATE inventory
+SET quantity = quantity - 1
+WHERE id = $1 AND quantity > 0
+RETURNING *;
+
+-- name: CreateActiveBoost :one
+INSERT INTO active_boosts (player_id, kind, multiplier, activated_at, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetBoost :one
+SELECT i.id AS inventory_id, i.player_id, i.quantity, i.description, b.kind, b.multiplier, b.duration_seconds
+FROM inventory i
+JOIN boosts b ON b.inventory_id = i.id
+WHERE i.id = $1;
+
+-- name: GetBoostsByPlayer :many
+SELECT i.id AS inventory_id, i.player_id, i.quantity, i.description, b.kind, b.multiplier, b.duration_seconds
+FROM inventory i
+JOIN boosts b ON b.inventory_id = i.id
+WHERE i.player_id = $1
+ORDER BY b.kind, i.created_at;
+
+-- name: ListActiveBoosts :many
+SELECT *
+FROM active_boosts
+WHERE player_id = $1 AND expires_at > now()
+ORDER BY expires_at;
@@ -0,0 +1,50 @@
+-- name: ListEggClusters :many
+-- Active eggs within bounds counted per grid cell and egg type, with the
+-- mean position of each group. Eggs are first moved to the center of their
+-- blur cell, so a cluster of a single egg does not give its position away.
+-- They then snap to cell centers, starting half a cell in from the bounds'
+-- south west corner, so cells nest in a map tile. The bounds are half open,
+-- so an egg on the edge between two tiles is only counted in one.
+SELECT
+  ST_X(ST_SnapToGrid(b.location, @xmin::float + @cell_width::float / 2, @ymin::float + @cell_height::float / 2, @cell_width::float, @cell_height::float))::float AS cell_lon,
+  ST_Y(ST_SnapToGrid(b.location, @xmin::float + @cell_width::float / 2, @ymin::float + @cell_height::float / 2, @cell_width::float, @cell_height::float))::float AS cell_lat,
+  e.type,
+  COUNT(*)::int AS count,
+  AVG(ST_Y(b.location))::float AS lat,
+  AVG(ST_X(b.location))::float AS lon
+FROM eggs e
+CROSS JOIN LATERAL (SELECT ST_SnapToGrid(e.location, @blur_cell::float) AS location) b
+WHERE e.location IS NOT NULL
+  AND e.state NOT IN ('HATCHED', 'DECAYED')
+  AND e.decays_at > now()
+  AND e.collected_at IS NULL
+  AND e.location && ST_Expand(ST_MakeEnvelope(@xmin::float, @ymin::float, @xmax::float, @ymax::float, 4326), @blur_cell::float)
+  AND ST_X(b.location) >= @xmin::float AND ST_X(b.location) < @xmax::float
+  AND ST_Y(b.location) >= @ymin::float AND ST_Y(b.location) < @ymax::float
+GROUP BY cell_lon, cell_lat, e.type
+ORDER BY cell_lat DESC, cell_lon, e.type;
+
+-- name: ListEggPointsInBounds :many
+-- Active eggs whose blur cell center lies within bounds, newest first. Both
+-- the exact position and the cell center are returned, the caller decides
+-- who may see which. The bounds are half open like ListEggClusters.
+SELECT
+  e.inventory_id,
+  i.player_id AS owner_id,
+  e.type,
+  ST_Y(e.location)::float AS lat,
+  ST_X(e.location)::float AS lon,
+  ST_Y(b.location)::float AS cell_lat,
+  ST_X(b.location)::float AS cell_lon
+FROM eggs e
+JOIN inventory i ON i.id = e.inventory_id
+CROSS JOIN LATERAL (SELECT ST_SnapToGrid(e.location, @blur_cell::float) AS location) b
+WHERE e.location IS NOT NULL
+  AND e.state NOT IN ('HATCHED', 'DECAYED')
+  AND e.decays_at > now()
+  AND e.collected_at IS NULL
+  AND e.location && ST_Expand(ST_MakeEnvelope(@xmin::float, @ymin::float, @xmax::float, @ymax::float, 4326), @blur_cell::float)
+  AND ST_X(b.location) >= @xmin::float AND ST_X(b.location) < @xmax::float
+  AND ST_Y(b.location) >= @ymin::float AND ST_Y(b.location) < @ymax::float
+ORDER BY e.planted_at DESC
+LIMIT @max_points::int;
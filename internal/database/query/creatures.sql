@@ -0,0 +1,37 @@
+-- name: ListSpeciesByEggType :many
+SELECT *
+FROM species
+WHERE egg_type = $1
+ORDER BY id;
+
+-- name: CreateCreature :one
+INSERT INTO inventory (player_id, item_type, quantity, description)
+VALUES ($1, 'CREATURE', 1, $2)
+RETURNING *;
+
+-- name: AddCreatureDetails :one
+INSERT INTO creatures (inventory_id, species_id, egg_id)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: ListCreaturesByPlayer :many
+SELECT i.id AS inventory_id, c.species_id, s.code AS species_code, s.name AS species_name,
+       s.egg_type, s.rarity, c.nickname, c.egg_id, c.hatched_at
+FROM inventory i
+JOIN creatures c ON c.inventory_id = i.id
+JOIN species s ON s.id = c.species_id
+WHERE i.player_id = $1
+ORDER BY c.hatched_at DESC;
+
+-- name: GetPlayerDex :many
+SELECT s.id, s.code, s.name, s.egg_type, s.rarity, s.description,
+       COUNT(owned.species_id)::int AS caught
+FROM species s
+LEFT JOIN (
+  SELECT c.species_id
+  FROM creatures c
+  JOIN inventory i ON i.id = c.inventory_id
+  WHERE i.player_id = $1
+) owned ON owned.species_id = s.id
+GROUP BY s.id
+ORDER BY s.egg_type, s.id;
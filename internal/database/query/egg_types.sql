@@ -0,0 +1,21 @@
+-- name: GetEggType :one
+SELECT *
+FROM egg_types
+WHERE code = $1;
+
+-- name: ListEnabledEggTypes :many
+SELECT *
+FROM egg_types
+WHERE enabled
+ORDER BY min_level, coin_cost, code;
+
+-- name: IsEggTypeUnlocked :one
+SELECT (
+  NOT t.requires_unlock OR EXISTS (
+    SELECT 1
+    FROM player_egg_types p
+    WHERE p.player_id = @player_id AND p.egg_type = t.code
+  )
+)::boolean AS unlocked
+FROM egg_types t
+WHERE t.code = @code;
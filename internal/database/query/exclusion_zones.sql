@@ -0,0 +1,25 @@
+-- name: CreateExclusionZone :one
+INSERT INTO exclusion_zones (name, description, area, created_by)
+VALUES (@name, @description, ST_Multi(ST_GeomFromText(@wkt::text, 4326)), @created_by)
+RETURNING id, name, description, created_by, created_at, ST_AsGeoJSON(area)::text AS geojson;
+
+-- name: CreateExclusionZoneFromGeoJSON :one
+INSERT INTO exclusion_zones (name, description, area, created_by)
+VALUES (@name, @description, ST_Multi(ST_SetSRID(ST_GeomFromGeoJSON(@geojson::text), 4326)), @created_by)
+RETURNING id, name, description, created_by, created_at, ST_AsGeoJSON(area)::text AS geojson;
+
+-- name: DeleteExclusionZone :execrows
+DELETE FROM exclusion_zones
+WHERE id = $1;
+
+-- name: IsPointExcluded :one
+SELECT EXISTS (
+  SELECT 1
+  FROM exclusion_zones z
+  WHERE ST_Intersects(z.area, ST_SetSRID(ST_MakePoint(@lon::float, @lat::float), 4326))
+)::boolean AS excluded;
+
+-- name: ListExclusionZones :many
+SELECT id, name, description, created_by, created_at, ST_AsGeoJSON(area)::text AS geojson
+FROM exclusion_zones
+ORDER BY created_at DESC;
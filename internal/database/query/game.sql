@@ -4,41 +4,206 @@ VALUES ($1, 'EGG', 1, $2)
 RETURNING *;
 
 -- name: AddEggDetails :one
-INSERT INTO eggs (inventory_id, type, message, location)
+INSERT INTO eggs (inventory_id, type, message, location, state, planted_at, incubates_at, ready_at, decays_at, shielded, dropped_by)
 VALUES (
   $1,
   $2,
   $3,
-  ST_SetSRID(ST_MakePoint(@lon::float, @lat::float), 4326)
+  ST_SetSRID(ST_MakePoint(@lon::float, @lat::float), 4326),
+  'PLANTED',
+  @planted_at,
+  @incubates_at,
+  @ready_at,
+  @decays_at,
+  @shielded,
+  (SELECT player_id FROM inventory WHERE id = $1)
 )
-RETURNING *;
+RETURNING inventory_id, hatched, type, message, collected_at, state, planted_at, incubates_at, ready_at, decays_at, shielded;
 
 -- name: GetEggsByPlayer :many
-SELECT i.id AS inventory_id, e.type, e.hatched, e.message, e.collected_at
+SELECT i.id AS inventory_id, e.type, e.hatched, e.message, e.collected_at,
+       e.state, e.planted_at, e.incubates_at, e.ready_at, e.decays_at
 FROM inventory i
 JOIN eggs e ON e.inventory_id = i.id
 WHERE i.player_id = $1;
 
+-- name: GetEgg :one
+SELECT i.id AS inventory_id, i.player_id, e.type, e.hatched, e.message,
+       e.state, e.planted_at, e.incubates_at, e.ready_at, e.decays_at, e.collected_at
+FROM inventory i
+JOIN eggs e ON e.inventory_id = i.id
+WHERE i.id = $1;
+
+-- name: CheckEggProximity :one
+SELECT
+  ST_Distance(e.location::geography, ST_SetSRID(ST_MakePoint(@lon::float, @lat::float), 4326)::geography)::float AS distance,
+  ST_DWithin(e.location::geography, ST_SetSRID(ST_MakePoint(@lon::float, @lat::float), 4326)::geography, @max_distance::float)::boolean AS within
+FROM eggs e
+WHERE e.inventory_id = @inventory_id AND e.location IS NOT NULL;
+
+-- name: GetEggForCollect :one
+-- Locks a dropped egg and its inventory row until the collecting
+-- transaction ends, so concurrent collectors queue up behind each other
+SELECT
+  i.player_id,
+  e.type,
+  e.state,
+  e.shielded,
+  e.decays_at,
+  e.collected_at,
+  ST_Distance(e.location::geography, ST_SetSRID(ST_MakePoint(@lon::float, @lat::float), 4326)::geography)::float AS distance
+FROM eggs e
+JOIN inventory i ON i.id = e.inventory_id
+WHERE e.inventory_id = @inventory_id AND e.location IS NOT NULL
+FOR UPDATE OF e, i;
+
+-- name: CollectEgg :one
+UPDATE eggs
+SET collected_at = @collected_at::timestamptz, collected_by = @collected_by::uuid
+WHERE inventory_id = @inventory_id AND collected_at IS NULL
+RETURNING inventory_id, collected_at, collected_by;
+
+-- name: TransferInventoryItem :one
+UPDATE inventory
+SET player_id = @to_player_id
+WHERE id = @id AND player_id = @from_player_id
+RETURNING *;
+
+-- name: TransitionEgg :one
+WITH updated AS (
+  UPDATE eggs
+  SET state = @to_state::varchar,
+      hatched = (@to_state::varchar = 'HATCHED')
+  WHERE eggs.inventory_id = @inventory_id AND eggs.state = @from_state::varchar
+  RETURNING eggs.inventory_id
+)
+INSERT INTO egg_transitions (inventory_id, from_state, to_state, transitioned_at)
+SELECT updated.inventory_id, @from_state::varchar, @to_state::varchar, @transitioned_at::timestamptz
+FROM updated
+RETURNING *;
+
 -- name: GetToolsByPlayer :many
-SELECT i.id AS inventory_id, t.durability, t.equipped, i.description
+SELECT i.id AS inventory_id, t.kind, t.slot, t.durability, t.equipped, i.description
 FROM inventory i
 JOIN tools t ON t.inventory_id = i.id
-WHERE i.player_id = $1;
+WHERE i.player_id = $1
+ORDER BY t.slot, i.created_at;
+
+-- name: CreateInventoryItem :one
+INSERT INTO inventory (player_id, item_type, quantity, description)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
 
 -- name: GetInventoryByPlayer :many
 SELECT *
 FROM inventory
 WHERE player_id = $1;
 
+-- name: CreatePlayer :one
+INSERT INTO players (account_id)
+VALUES ($1)
+RETURNING *;
+
+-- name: CreateTool :one
+INSERT INTO inventory (player_id, item_type, quantity, description)
+VALUES ($1, 'TOOL', 1, $2)
+RETURNING *;
+
+-- name: AddToolDetails :one
+INSERT INTO tools (inventory_id, kind, slot)
+VALUES ($1, $2, $3)
+RETURNING *;
+
 -- name: GetPlayerByAccount :one
 SELECT *
 FROM players
 WHERE account_id = $1;
 
+-- name: GetPlayer :one
+SELECT *
+FROM players
+WHERE id = $1;
+
+-- name: GetPlayerDropStats :one
+SELECT
+  COUNT(*) FILTER (
+    WHERE e.state NOT IN ('HATCHED', 'DECAYED') AND e.decays_at > now() AND e.collected_at IS NULL
+  )::int AS active_eggs,
+  COALESCE(MAX(e.planted_at), 'epoch'::timestamptz)::timestamptz AS last_dropped_at
+FROM eggs e
+WHERE e.dropped_by = @player_id::uuid;
+
+-- name: HasEggWithin :one
+SELECT EXISTS (
+  SELECT 1
+  FROM eggs e
+  WHERE e.state NOT IN ('HATCHED', 'DECAYED')
+    AND e.decays_at > now()
+    AND e.collected_at IS NULL
+    AND ST_DWithin(e.location::geography, ST_SetSRID(ST_MakePoint(@lon::float, @lat::float), 4326)::geography, @distance::float)
+)::boolean AS exists;
+
 -- name: UpdatePlayerStats :one
 UPDATE players
 SET coins = coins + $2,
     xp = xp + $3,
     updated_at = now()
-WHERE id = $1
+WHERE id = $1 AND coins + $2 >= 0
 RETURNING *;
+
+-- name: ListEggsWithinRadius :many
+-- Other players' eggs are searched, placed and measured at the center of
+-- their blur cell, only the player's own eggs at their exact position.
+SELECT
+  i.id AS inventory_id,
+  i.player_id AS owner_id,
+  a.username AS owner_username,
+  e.type,
+  e.hatched,
+  e.message,
+  ST_Y(shown.location)::float AS lat,
+  ST_X(shown.location)::float AS lon,
+  ST_Distance(shown.location::geography, ST_SetSRID(ST_MakePoint(@lon::float, @lat::float), 4326)::geography)::float AS distance
+FROM eggs e
+JOIN inventory i ON i.id = e.inventory_id
+JOIN players p ON p.id = i.player_id
+JOIN accounts a ON a.id = p.account_id
+CROSS JOIN LATERAL (
+  SELECT CASE WHEN i.player_id = @player_id::uuid THEN e.location ELSE ST_SnapToGrid(e.location, @blur_cell::float) END AS location
+) shown
+WHERE e.location IS NOT NULL
+  AND e.state NOT IN ('HATCHED', 'DECAYED')
+  AND e.decays_at > now()
+  AND e.collected_at IS NULL
+  AND ST_DWithin(e.location::geography, ST_SetSRID(ST_MakePoint(@lon::float, @lat::float), 4326)::geography, @radius::float + @blur_margin::float)
+  AND ST_DWithin(shown.location::geography, ST_SetSRID(ST_MakePoint(@lon::float, @lat::float), 4326)::geography, @radius::float)
+ORDER BY distance
+LIMIT @page_limit::int OFFSET @page_offset::int;
+
+-- name: ListEggsInBoundingBox :many
+-- Placed like ListEggsWithinRadius
+SELECT
+  i.id AS inventory_id,
+  i.player_id AS owner_id,
+  a.username AS owner_username,
+  e.type,
+  e.hatched,
+  e.message,
+  ST_Y(shown.location)::float AS lat,
+  ST_X(shown.location)::float AS lon,
+  ST_Distance(shown.location::geography, ST_SetSRID(ST_MakePoint(@lon::float, @lat::float), 4326)::geography)::float AS distance
+FROM eggs e
+JOIN inventory i ON i.id = e.inventory_id
+JOIN players p ON p.id = i.player_id
+JOIN accounts a ON a.id = p.account_id
+CROSS JOIN LATERAL (
+  SELECT CASE WHEN i.player_id = @player_id::uuid THEN e.location ELSE ST_SnapToGrid(e.location, @blur_cell::float) END AS location
+) shown
+WHERE e.location IS NOT NULL
+  AND e.state NOT IN ('HATCHED', 'DECAYED')
+  AND e.decays_at > now()
+  AND e.collected_at IS NULL
+  AND e.location && ST_Expand(ST_MakeEnvelope(@xmin::float, @ymin::float, @xmax::float, @ymax::float, 4326), @blur_cell::float)
+  AND shown.location && ST_MakeEnvelope(@xmin::float, @ymin::float, @xmax::float, @ymax::float, 4326)
+ORDER BY distance
+LIMIT @page_limit::int OFFSET @page_offset::int;
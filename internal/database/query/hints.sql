@@ -0,0 +1,14 @@
+-- name: GetEggHint :one
+-- Where an egg still in the world is and its distance and bearing, in
+-- degrees clockwise from north, from a position
+SELECT
+  ST_Y(e.location)::float AS lat,
+  ST_X(e.location)::float AS lon,
+  ST_Distance(e.location::geography, ST_SetSRID(ST_MakePoint(@lon::float, @lat::float), 4326)::geography)::float AS distance,
+  COALESCE(degrees(ST_Azimuth(ST_SetSRID(ST_MakePoint(@lon::float, @lat::float), 4326)::geography, e.location::geography)), 0)::float AS bearing
+FROM eggs e
+WHERE e.inventory_id = @inventory_id
+  AND e.location IS NOT NULL
+  AND e.state NOT IN ('HATCHED', 'DECAYED')
+  AND e.decays_at > now()
+  AND e.collected_at IS NULL;
@@ -0,0 +1,52 @@
+-- name: ListLeaderboardScores :many
+SELECT
+  p.id AS player_id,
+  p.settings,
+  COALESCE(x.xp, 0)::bigint AS xp,
+  COALESCE(h.hatched, 0)::bigint AS eggs_hatched,
+  COALESCE(d.distance, 0)::float AS distance
+FROM players p
+LEFT JOIN (
+  SELECT player_id, SUM(amount) AS xp
+  FROM ledger_entries
+  WHERE currency = 'XP' AND player_id IS NOT NULL AND created_at >= @since
+  GROUP BY player_id
+) x ON x.player_id = p.id
+LEFT JOIN (
+  SELECT i.player_id, COUNT(*) AS hatched
+  FROM egg_transitions t
+  JOIN inventory i ON i.id = t.inventory_id
+  WHERE t.to_state = 'HATCHED' AND t.transitioned_at >= @since
+  GROUP BY i.player_id
+) h ON h.player_id = p.id
+LEFT JOIN (
+  -- distance travelled, counted per UTC day as locations are reported
+  SELECT player_id, SUM(meters) AS distance
+  FROM player_distances
+  WHERE day >= (@since::timestamptz AT TIME ZONE 'UTC')::date
+  GROUP BY player_id
+) d ON d.player_id = p.id
+WHERE sqlc.narg(player_id)::uuid IS NULL OR p.id = sqlc.narg(player_id)::uuid;
+
+-- name: ListPlayerUsernames :many
+SELECT p.id AS player_id, a.username
+FROM players p
+JOIN accounts a ON a.id = p.account_id
+WHERE p.id = ANY(@player_ids::uuid[]);
+
+-- name: QueueLeaderboardUpdate :exec
+-- Queued again, an update already waiting moves to the back
+INSERT INTO leaderboard_updates (player_id)
+VALUES ($1)
+ON CONFLICT (player_id) DO UPDATE SET queued_at = clock_timestamp();
+
+-- name: ListLeaderboardUpdates :many
+SELECT player_id, queued_at
+FROM leaderboard_updates
+ORDER BY queued_at
+LIMIT $1;
+
+-- name: DeleteLeaderboardUpdate :exec
+-- Only the update that was read goes, one queued again since stays
+DELETE FROM leaderboard_updates
+WHERE player_id = $1 AND queued_at = $2;
@@ -0,0 +1,61 @@
+-- name: CreateLedgerEntry :one
+INSERT INTO ledger_entries (
+  transaction_id, account, player_id, currency, amount, reason, reference_type, reference_id, idempotency_key
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9
+)
+RETURNING *;
+
+-- name: LedgerKeyExists :one
+SELECT EXISTS (
+  SELECT 1
+  FROM ledger_entries
+  WHERE idempotency_key = $1
+)::boolean AS exists;
+
+-- name: ListLedgerEntriesByPlayer :many
+SELECT h.id, h.transaction_id, h.currency, h.amount, h.balance_after, h.reason, h.reference_type, h.reference_id, h.created_at
+FROM (
+  SELECT e.*, SUM(e.amount) OVER (PARTITION BY e.currency ORDER BY e.id)::bigint AS balance_after
+  FROM ledger_entries e
+  WHERE e.player_id = @player_id::uuid
+) h
+WHERE @currency::text = '' OR h.currency = @currency::text
+ORDER BY h.id DESC
+LIMIT @page_limit::int OFFSET @page_offset::int;
+
+-- name: ListLedgerMismatches :many
+SELECT
+  p.id AS player_id,
+  p.coins,
+  p.xp,
+  COALESCE(l.coins, 0)::bigint AS ledger_coins,
+  COALESCE(l.xp, 0)::bigint AS ledger_xp
+FROM players p
+LEFT JOIN (
+  SELECT
+    player_id,
+    SUM(amount) FILTER (WHERE currency = 'COIN') AS coins,
+    SUM(amount) FILTER (WHERE currency = 'XP') AS xp
+  FROM ledger_entries
+  WHERE player_id IS NOT NULL
+  GROUP BY player_id
+) l ON l.player_id = p.id
+WHERE p.coins <> COALESCE(l.coins, 0) OR p.xp <> COALESCE(l.xp, 0)
+ORDER BY p.id;
+
+-- name: ListUnbalancedLedgerTransactions :many
+SELECT transaction_id, currency, SUM(amount)::bigint AS total
+FROM ledger_entries
+GROUP BY transaction_id, currency
+HAVING SUM(amount) <> 0
+ORDER BY transaction_id;
+
+-- name: SetPlayerBalances :one
+UPDATE players
+SET coins = @coins,
+    xp = @xp,
+    level = @level,
+    updated_at = now()
+WHERE id = @id
+RETURNING *;
@@ -0,0 +1,12 @@
+-- name: ListLevelRewards :many
+SELECT *
+FROM level_rewards
+WHERE level > @from_level::int AND level <= @to_level::int
+ORDER BY level;
+
+-- name: SetPlayerLevel :one
+UPDATE players
+SET level = $2,
+    updated_at = now()
+WHERE id = $1
+RETURNING *;
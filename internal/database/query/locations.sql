@@ -0,0 +1,34 @@
+-- name: CreatePlayerLocation :one
+INSERT INTO player_locations (player_id, location, accuracy_meters, recorded_at)
+VALUES (
+  @player_id,
+  ST_SetSRID(ST_MakePoint(@lon::float, @lat::float), 4326),
+  sqlc.narg(accuracy_meters),
+  @recorded_at
+)
+RETURNING id, player_id, ST_Y(location)::float AS lat, ST_X(location)::float AS lon, accuracy_meters, recorded_at;
+
+-- name: GetLastPlayerLocation :one
+SELECT id, player_id, ST_Y(location)::float AS lat, ST_X(location)::float AS lon, accuracy_meters, recorded_at
+FROM player_locations
+WHERE player_id = $1
+ORDER BY recorded_at DESC
+LIMIT 1;
+
+-- name: DeletePlayerLocationsBefore :execrows
+DELETE FROM player_locations
+WHERE recorded_at < @before::timestamptz;
+
+-- name: AddDistanceTravelled :exec
+-- Adds the meters between two locations to the day the player reached the
+-- second one
+INSERT INTO player_distances (player_id, day, meters)
+VALUES (
+  @player_id,
+  (@recorded_at::timestamptz AT TIME ZONE 'UTC')::date,
+  ST_Distance(
+    ST_SetSRID(ST_MakePoint(@from_lon::float, @from_lat::float), 4326)::geography,
+    ST_SetSRID(ST_MakePoint(@to_lon::float, @to_lat::float), 4326)::geography
+  )
+)
+ON CONFLICT (player_id, day) DO UPDATE SET meters = player_distances.meters + EXCLUDED.meters;
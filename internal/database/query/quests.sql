@@ -0,0 +1,41 @@
+-- name: AdvanceQuest :one
+INSERT INTO player_quests AS pq (player_id, template_code, period_start, progress, completed_at)
+VALUES (
+  @player_id, @template_code, @period_start, @amount::bigint,
+  CASE WHEN @amount::bigint >= @target::bigint THEN now() END
+)
+ON CONFLICT (player_id, template_code, period_start) DO UPDATE
+SET progress = CASE WHEN @keep_max::boolean THEN GREATEST(pq.progress, EXCLUDED.progress) ELSE pq.progress + EXCLUDED.progress END,
+    completed_at = COALESCE(
+      pq.completed_at,
+      CASE WHEN (CASE WHEN @keep_max::boolean THEN GREATEST(pq.progress, EXCLUDED.progress) ELSE pq.progress + EXCLUDED.progress END) >= @target::bigint THEN now() END
+    ),
+    updated_at = now()
+RETURNING *, COALESCE(completed_at = now(), false)::boolean AS just_completed;
+
+-- name: ClaimQuest :one
+UPDATE player_quests
+SET claimed_at = now(),
+    updated_at = now()
+WHERE player_id = $1
+  AND template_code = $2
+  AND period_start = $3
+  AND completed_at IS NOT NULL
+  AND claimed_at IS NULL
+RETURNING *;
+
+-- name: GetPlayerQuest :one
+SELECT *
+FROM player_quests
+WHERE player_id = $1 AND template_code = $2 AND period_start = $3;
+
+-- name: ListPlayerQuests :many
+SELECT *
+FROM player_quests
+WHERE player_id = @player_id AND period_start = ANY(@period_starts::date[]);
+
+-- name: ListQuestTemplates :many
+SELECT *
+FROM quest_templates
+WHERE enabled AND period = $1
+ORDER BY code;
@@ -0,0 +1,26 @@
+-- name: CreatePurchase :one
+INSERT INTO purchases (player_id, shop_item_id, request_key, packs, price, inventory_ids)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: GetPurchaseByKey :one
+SELECT *
+FROM purchases
+WHERE player_id = $1 AND request_key = $2;
+
+-- name: GetShopItemByCode :one
+SELECT *
+FROM shop_items
+WHERE code = $1;
+
+-- name: ListShopItems :many
+SELECT *
+FROM shop_items
+WHERE enabled
+ORDER BY item_type, price, code;
+
+-- name: UnlockEggType :one
+INSERT INTO player_egg_types (player_id, egg_type)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING
+RETURNING *;
@@ -0,0 +1,144 @@
+-- name: ClaimSpawn :exec
+UPDATE egg_spawns
+SET collected_at = @collected_at::timestamptz,
+    collected_by = @collected_by::uuid
+WHERE id = @id AND collected_at IS NULL;
+
+-- name: CountSpawnsWithin :one
+SELECT COUNT(*)::int AS spawns
+FROM egg_spawns s
+WHERE s.collected_at IS NULL
+  AND s.expires_at > @now::timestamptz
+  AND ST_DWithin(s.location::geography, ST_SetSRID(ST_MakePoint(@lon::float, @lat::float), 4326)::geography, @radius::float);
+
+-- name: CreateEggFromSpawn :one
+-- The egg a claimed spawn turns into. It is carried by the finder from the
+-- start and was dropped by nobody.
+INSERT INTO eggs (inventory_id, type, location, state, planted_at, incubates_at, ready_at, decays_at, collected_at, collected_by)
+SELECT
+  @inventory_id::uuid,
+  s.egg_type,
+  s.location,
+  'PLANTED',
+  @planted_at::timestamptz,
+  @incubates_at::timestamptz,
+  @ready_at::timestamptz,
+  @decays_at::timestamptz,
+  s.collected_at,
+  s.collected_by
+FROM egg_spawns s
+WHERE s.id = @spawn_id
+RETURNING inventory_id, type, collected_at, state, planted_at, incubates_at, ready_at, decays_at;
+
+-- name: CreateSpawn :one
+INSERT INTO egg_spawns (egg_type, location, spawn_point_id, spawned_at, expires_at)
+VALUES (
+  @egg_type,
+  ST_SetSRID(ST_MakePoint(@lon::float, @lat::float), 4326),
+  sqlc.narg(spawn_point_id),
+  @spawned_at,
+  @expires_at
+)
+RETURNING id, egg_type, spawn_point_id, spawned_at, expires_at;
+
+-- name: CreateSpawnPoint :one
+INSERT INTO spawn_points (name, location, radius_meters, max_active, enabled)
+VALUES (
+  @name,
+  ST_SetSRID(ST_MakePoint(@lon::float, @lat::float), 4326),
+  @radius_meters,
+  @max_active,
+  @enabled
+)
+RETURNING id, name, ST_Y(location)::float AS lat, ST_X(location)::float AS lon, radius_meters, max_active, enabled, created_at;
+
+-- name: DeleteExpiredSpawns :execrows
+DELETE FROM egg_spawns
+WHERE collected_at IS NULL AND expires_at <= @now::timestamptz;
+
+-- name: DeleteSpawnPoint :execrows
+DELETE FROM spawn_points
+WHERE id = $1;
+
+-- name: GetSpawn :one
+SELECT id, egg_type, spawned_at, expires_at, collected_at
+FROM egg_spawns
+WHERE id = $1;
+
+-- name: GetSpawnForClaim :one
+-- A spawn and its distance from the finder, locked until the claim commits
+SELECT
+  s.id,
+  s.egg_type,
+  ST_Distance(s.location::geography, ST_SetSRID(ST_MakePoint(@lon::float, @lat::float), 4326)::geography)::float AS distance,
+  s.expires_at,
+  s.collected_at
+FROM egg_spawns s
+WHERE s.id = @id
+FOR UPDATE;
+
+-- name: HasSpawnWithin :one
+SELECT EXISTS (
+  SELECT 1
+  FROM egg_spawns s
+  WHERE s.collected_at IS NULL
+    AND s.expires_at > @now::timestamptz
+    AND ST_DWithin(s.location::geography, ST_SetSRID(ST_MakePoint(@lon::float, @lat::float), 4326)::geography, @distance::float)
+)::boolean AS exists;
+
+-- name: ListActivePlayerPositions :many
+-- Where players who reported their location recently were last seen
+SELECT DISTINCT ON (l.player_id)
+  l.player_id,
+  ST_Y(l.location)::float AS lat,
+  ST_X(l.location)::float AS lon
+FROM player_locations l
+WHERE l.recorded_at >= @active_since::timestamptz
+ORDER BY l.player_id, l.recorded_at DESC;
+
+-- name: ListAllSpawnPoints :many
+-- Every spawn point, including disabled ones
+SELECT
+  id,
+  name,
+  ST_Y(location)::float AS lat,
+  ST_X(location)::float AS lon,
+  radius_meters,
+  max_active,
+  enabled,
+  created_at
+FROM spawn_points
+ORDER BY id;
+
+-- name: ListSpawnPoints :many
+SELECT
+  id,
+  name,
+  ST_Y(location)::float AS lat,
+  ST_X(location)::float AS lon,
+  radius_meters,
+  max_active
+FROM spawn_points
+WHERE enabled
+ORDER BY id;
+
+-- name: ListSpawnableEggTypes :many
+SELECT code, spawn_weight
+FROM egg_types
+WHERE enabled AND spawn_weight > 0
+ORDER BY code;
+
+-- name: ListSpawnsWithinRadius :many
+SELECT
+  s.id,
+  s.egg_type,
+  ST_Y(s.location)::float AS lat,
+  ST_X(s.location)::float AS lon,
+  ST_Distance(s.location::geography, ST_SetSRID(ST_MakePoint(@lon::float, @lat::float), 4326)::geography)::float AS distance,
+  s.expires_at
+FROM egg_spawns s
+WHERE s.collected_at IS NULL
+  AND s.expires_at > now()
+  AND ST_DWithin(s.location::geography, ST_SetSRID(ST_MakePoint(@lon::float, @lat::float), 4326)::geography, @radius::float)
+ORDER BY distance
+LIMIT @page_limit::int OFFSET @page_offset::int;
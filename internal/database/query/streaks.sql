@@ -0,0 +1,39 @@
+-- name: AddStreakFreezes :one
+INSERT INTO login_streaks (player_id, freezes)
+VALUES ($1, $2)
+ON CONFLICT (player_id) DO UPDATE
+SET freezes = login_streaks.freezes + EXCLUDED.freezes,
+    updated_at = now()
+RETURNING *;
+
+-- name: CreateDailyRewardClaim :one
+INSERT INTO daily_reward_claims (player_id, claimed_on, day, streak)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT DO NOTHING
+RETURNING *;
+
+-- name: GetDailyRewardClaim :one
+SELECT *
+FROM daily_reward_claims
+WHERE player_id = $1 AND claimed_on = $2;
+
+-- name: GetLoginStreak :one
+SELECT *
+FROM login_streaks
+WHERE player_id = $1;
+
+-- name: ListDailyRewards :many
+SELECT *
+FROM daily_rewards
+ORDER BY day;
+
+-- name: SetLoginStreak :one
+INSERT INTO login_streaks (player_id, current, longest, last_active_on, freezes)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (player_id) DO UPDATE
+SET current = EXCLUDED.current,
+    longest = EXCLUDED.longest,
+    last_active_on = EXCLUDED.last_active_on,
+    freezes = EXCLUDED.freezes,
+    updated_at = now()
+RETURNING *;
@@ -0,0 +1,37 @@
+-- name: DeleteInventoryItem :exec
+DELETE FROM inventory
+WHERE id = $1;
+
+-- name: GetEquippedTool :one
+SELECT i.id AS inventory_id, i.player_id, t.kind, t.slot, t.durability, t.equipped
+FROM inventory i
+JOIN tools t ON t.inventory_id = i.id
+WHERE i.player_id = @player_id AND t.kind = @kind AND t.equipped AND t.durability > 0
+LIMIT 1;
+
+-- name: GetTool :one
+SELECT i.id AS inventory_id, i.player_id, t.kind, t.slot, t.durability, t.equipped, i.description
+FROM inventory i
+JOIN tools t ON t.inventory_id = i.id
+WHERE i.id = $1;
+
+-- name: SetToolEquipped :one
+UPDATE tools
+SET equipped = @equipped
+WHERE inventory_id = @inventory_id
+RETURNING *;
+
+-- name: UnequipSlot :exec
+UPDATE tools t
+SET equipped = false
+FROM inventory i
+WHERE i.id = t.inventory_id
+  AND i.player_id = @player_id
+  AND t.slot = @slot
+  AND t.equipped;
+
+-- name: WearTool :one
+UPDATE tools
+SET durability = GREATEST(durability - @wear::int, 0)
+WHERE inventory_id = @inventory_id AND durability > 0
+RETURNING *;
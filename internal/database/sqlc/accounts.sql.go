@@ -267,6 +267,52 @@ func (q *Queries) ListAccounts(ctx context.Context, arg ListAccountsParams) ([]A
 	return items, nil
 }
 
+const listAccountsWithoutPlayer = `-- name: ListAccountsWithoutPlayer :many
+SELECT a.id, a.first_name, a.last_name, a.username, a.email, a.password, a.profile_url, a.status, a.role, a.is_2fa_enabled, a.otp_code, a.otp_expires_at, a.is_approved, a.is_verified, a.created_at, a.last_active, a.updated_at
+FROM accounts a
+LEFT JOIN players p ON p.account_id = a.id
+WHERE p.id IS NULL
+ORDER BY a.created_at
+`
+
+func (q *Queries) ListAccountsWithoutPlayer(ctx context.Context) ([]Accounts, error) {
+	rows, err := q.db.Query(ctx, listAccountsWithoutPlayer)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Accounts{}
+	for rows.Next() {
+		var i Accounts
+		if err := rows.Scan(
+			&i.ID,
+			&i.FirstName,
+			&i.LastName,
+			&i.Username,
+			&i.Email,
+			&i.Password,
+			&i.ProfileUrl,
+			&i.Status,
+			&i.Role,
+			&i.Is2faEnabled,
+			&i.OtpCode,
+			&i.OtpExpiresAt,
+			&i.IsApproved,
+			&i.IsVerified,
+			&i.CreatedAt,
+			&i.LastActive,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateAccountLastActive = `-- name: UpdateAccountLastActive :exec
 UPDATE "accounts"
 SET last_active = now()
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/0xdbb/eggsplore/internal/game"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -12,6 +13,7 @@ import (
 type Service struct {
 	*Queries
 	connPool *pgxpool.Pool
+	xpCurve  game.XPCurve
 }
 
 func (s *Service) Health() {
@@ -27,6 +29,7 @@ func NewService(connString string) *Service {
 	newService := &Service{
 		connPool: connPool,
 		Queries:  New(connPool),
+		xpCurve:  game.DefaultXPCurve,
 	}
 
 	return newService
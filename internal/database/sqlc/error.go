@@ -2,18 +2,36 @@ package database
 
 import (
 	"errors"
+	"strings"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 )
 
 const (
-	ForeignKeyViolation = "23503"
-	UniqueViolation     = "23505"
+	CheckViolation       = "23514"
+	ForeignKeyViolation  = "23503"
+	UniqueViolation      = "23505"
+	SerializationFailure = "40001"
+	DeadlockDetected     = "40P01"
 )
 
 var ErrRecordNotFound = pgx.ErrNoRows
 
+var ErrInsufficientCoins = errors.New("insufficient coins")
+
+var ErrUnknownToolKind = errors.New("unknown tool kind")
+
+var ErrAlreadyUnlocked = errors.New("already unlocked")
+
+var ErrNotUnlocked = errors.New("not unlocked yet")
+
+var ErrNotCompleted = errors.New("not completed yet")
+
+var ErrAlreadyClaimed = errors.New("already claimed")
+
+var ErrRequestKeyReused = errors.New("request key already used for a different request")
+
 var ErrUniqueViolation = &pgconn.PgError{
 	Code: UniqueViolation,
 }
@@ -25,3 +43,18 @@ func ErrorCode(err error) string {
 	}
 	return ""
 }
+
+// IsGeometryError reports whether postgres rejected a geometry value, either
+// because PostGIS could not parse it or because it failed a validity check.
+func IsGeometryError(err error) bool {
+	code := ErrorCode(err)
+	switch {
+	case code == CheckViolation:
+		return true
+	case code == "XX000": // PostGIS parse errors are raised as internal errors
+		return true
+	case strings.HasPrefix(code, "22"): // data exceptions, e.g. wrong geometry type
+		return true
+	}
+	return false
+}
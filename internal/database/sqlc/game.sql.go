@@ -14,14 +14,21 @@ import (
 )
 
 const addEggDetails = `-- name: AddEggDetails :one
-INSERT INTO eggs (inventory_id, type, message, location)
+INSERT INTO eggs (inventory_id, type, message, location, state, planted_at, incubates_at, ready_at, decays_at, shielded, dropped_by)
 VALUES (
   $1,
   $2,
   $3,
-  ST_SetSRID(ST_MakePoint($4::float, $5::float), 4326)
+  ST_SetSRID(ST_MakePoint($4::float, $5::float), 4326),
+  'PLANTED',
+  $6,
+  $7,
+  $8,
+  $9,
+  $10,
+  (SELECT player_id FROM inventory WHERE id = $1)
 )
-RETURNING inventory_id, hatched, type, message, collected_at
+RETURNING inventory_id, hatched, type, message, collected_at, state, planted_at, incubates_at, ready_at, decays_at, shielded
 `
 
 type AddEggDetailsParams struct {
@@ -30,24 +37,137 @@ type AddEggDetailsParams struct {
 	Message     pgtype.Text `json:"message"`
 	Lon         float64     `json:"lon"`
 	Lat         float64     `json:"lat"`
+	PlantedAt   time.Time   `json:"planted_at"`
+	IncubatesAt time.Time   `json:"incubates_at"`
+	ReadyAt     time.Time   `json:"ready_at"`
+	DecaysAt    time.Time   `json:"decays_at"`
+	Shielded    bool        `json:"shielded"`
 }
 
-func (q *Queries) AddEggDetails(ctx context.Context, arg AddEggDetailsParams) (Eggs, error) {
+type AddEggDetailsRow struct {
+	InventoryID uuid.UUID          `json:"inventory_id"`
+	Hatched     pgtype.Bool        `json:"hatched"`
+	Type        string             `json:"type"`
+	Message     pgtype.Text        `json:"message"`
+	CollectedAt pgtype.Timestamptz `json:"collected_at"`
+	State       string             `json:"state"`
+	PlantedAt   time.Time          `json:"planted_at"`
+	IncubatesAt time.Time          `json:"incubates_at"`
+	ReadyAt     time.Time          `json:"ready_at"`
+	DecaysAt    time.Time          `json:"decays_at"`
+	Shielded    bool               `json:"shielded"`
+}
+
+func (q *Queries) AddEggDetails(ctx context.Context, arg AddEggDetailsParams) (AddEggDetailsRow, error) {
 	row := q.db.QueryRow(ctx, addEggDetails,
 		arg.InventoryID,
 		arg.Type,
 		arg.Message,
 		arg.Lon,
 		arg.Lat,
+		arg.PlantedAt,
+		arg.IncubatesAt,
+		arg.ReadyAt,
+		arg.DecaysAt,
+		arg.Shielded,
 	)
-	var i Eggs
+	var i AddEggDetailsRow
 	err := row.Scan(
 		&i.InventoryID,
 		&i.Hatched,
 		&i.Type,
 		&i.Message,
 		&i.CollectedAt,
+		&i.State,
+		&i.PlantedAt,
+		&i.IncubatesAt,
+		&i.ReadyAt,
+		&i.DecaysAt,
+		&i.Shielded,
+	)
+	return i, err
+}
+
+const addToolDetails = `-- name: AddToolDetails :one
+INSERT INTO tools (inventory_id, kind, slot)
+VALUES ($1, $2, $3)
+RETURNING inventory_id, durability, equipped, kind, slot
+`
+
+type AddToolDetailsParams struct {
+	InventoryID uuid.UUID `json:"inventory_id"`
+	Kind        string    `json:"kind"`
+	Slot        string    `json:"slot"`
+}
+
+func (q *Queries) AddToolDetails(ctx context.Context, arg AddToolDetailsParams) (Tools, error) {
+	row := q.db.QueryRow(ctx, addToolDetails, arg.InventoryID, arg.Kind, arg.Slot)
+	var i Tools
+	err := row.Scan(
+		&i.InventoryID,
+		&i.Durability,
+		&i.Equipped,
+		&i.Kind,
+		&i.Slot,
+	)
+	return i, err
+}
+
+const checkEggProximity = `-- name: CheckEggProximity :one
+SELECT
+  ST_Distance(e.location::geography, ST_SetSRID(ST_MakePoint($1::float, $2::float), 4326)::geography)::float AS distance,
+  ST_DWithin(e.location::geography, ST_SetSRID(ST_MakePoint($1::float, $2::float), 4326)::geography, $3::float)::boolean AS within
+FROM eggs e
+WHERE e.inventory_id = $4 AND e.location IS NOT NULL
+`
+
+type CheckEggProximityParams struct {
+	Lon         float64   `json:"lon"`
+	Lat         float64   `json:"lat"`
+	MaxDistance float64   `json:"max_distance"`
+	InventoryID uuid.UUID `json:"inventory_id"`
+}
+
+type CheckEggProximityRow struct {
+	Distance float64 `json:"distance"`
+	Within   bool    `json:"within"`
+}
+
+func (q *Queries) CheckEggProximity(ctx context.Context, arg CheckEggProximityParams) (CheckEggProximityRow, error) {
+	row := q.db.QueryRow(ctx, checkEggProximity,
+		arg.Lon,
+		arg.Lat,
+		arg.MaxDistance,
+		arg.InventoryID,
 	)
+	var i CheckEggProximityRow
+	err := row.Scan(&i.Distance, &i.Within)
+	return i, err
+}
+
+const collectEgg = `-- name: CollectEgg :one
+UPDATE eggs
+SET collected_at = $1::timestamptz, collected_by = $2::uuid
+WHERE inventory_id = $3 AND collected_at IS NULL
+RETURNING inventory_id, collected_at, collected_by
+`
+
+type CollectEggParams struct {
+	CollectedAt time.Time `json:"collected_at"`
+	CollectedBy uuid.UUID `json:"collected_by"`
+	InventoryID uuid.UUID `json:"inventory_id"`
+}
+
+type CollectEggRow struct {
+	InventoryID uuid.UUID          `json:"inventory_id"`
+	CollectedAt pgtype.Timestamptz `json:"collected_at"`
+	CollectedBy pgtype.UUID        `json:"collected_by"`
+}
+
+func (q *Queries) CollectEgg(ctx context.Context, arg CollectEggParams) (CollectEggRow, error) {
+	row := q.db.QueryRow(ctx, collectEgg, arg.CollectedAt, arg.CollectedBy, arg.InventoryID)
+	var i CollectEggRow
+	err := row.Scan(&i.InventoryID, &i.CollectedAt, &i.CollectedBy)
 	return i, err
 }
 
@@ -76,19 +196,193 @@ func (q *Queries) CreateEgg(ctx context.Context, arg CreateEggParams) (Inventory
 	return i, err
 }
 
+const createInventoryItem = `-- name: CreateInventoryItem :one
+INSERT INTO inventory (player_id, item_type, quantity, description)
+VALUES ($1, $2, $3, $4)
+RETURNING id, player_id, item_type, quantity, description, created_at
+`
+
+type CreateInventoryItemParams struct {
+	PlayerID    uuid.UUID   `json:"player_id"`
+	ItemType    string      `json:"item_type"`
+	Quantity    int32       `json:"quantity"`
+	Description pgtype.Text `json:"description"`
+}
+
+func (q *Queries) CreateInventoryItem(ctx context.Context, arg CreateInventoryItemParams) (Inventory, error) {
+	row := q.db.QueryRow(ctx, createInventoryItem,
+		arg.PlayerID,
+		arg.ItemType,
+		arg.Quantity,
+		arg.Description,
+	)
+	var i Inventory
+	err := row.Scan(
+		&i.ID,
+		&i.PlayerID,
+		&i.ItemType,
+		&i.Quantity,
+		&i.Description,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createPlayer = `-- name: CreatePlayer :one
+INSERT INTO players (account_id)
+VALUES ($1)
+RETURNING id, account_id, coins, xp, level, settings, created_at, updated_at
+`
+
+func (q *Queries) CreatePlayer(ctx context.Context, accountID uuid.UUID) (Players, error) {
+	row := q.db.QueryRow(ctx, createPlayer, accountID)
+	var i Players
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Coins,
+		&i.Xp,
+		&i.Level,
+		&i.Settings,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createTool = `-- name: CreateTool :one
+INSERT INTO inventory (player_id, item_type, quantity, description)
+VALUES ($1, 'TOOL', 1, $2)
+RETURNING id, player_id, item_type, quantity, description, created_at
+`
+
+type CreateToolParams struct {
+	PlayerID    uuid.UUID   `json:"player_id"`
+	Description pgtype.Text `json:"description"`
+}
+
+func (q *Queries) CreateTool(ctx context.Context, arg CreateToolParams) (Inventory, error) {
+	row := q.db.QueryRow(ctx, createTool, arg.PlayerID, arg.Description)
+	var i Inventory
+	err := row.Scan(
+		&i.ID,
+		&i.PlayerID,
+		&i.ItemType,
+		&i.Quantity,
+		&i.Description,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getEgg = `-- name: GetEgg :one
+SELECT i.id AS inventory_id, i.player_id, e.type, e.hatched, e.message,
+       e.state, e.planted_at, e.incubates_at, e.ready_at, e.decays_at, e.collected_at
+FROM inventory i
+JOIN eggs e ON e.inventory_id = i.id
+WHERE i.id = $1
+`
+
+type GetEggRow struct {
+	InventoryID uuid.UUID          `json:"inventory_id"`
+	PlayerID    uuid.UUID          `json:"player_id"`
+	Type        string             `json:"type"`
+	Hatched     pgtype.Bool        `json:"hatched"`
+	Message     pgtype.Text        `json:"message"`
+	State       string             `json:"state"`
+	PlantedAt   time.Time          `json:"planted_at"`
+	IncubatesAt time.Time          `json:"incubates_at"`
+	ReadyAt     time.Time          `json:"ready_at"`
+	DecaysAt    time.Time          `json:"decays_at"`
+	CollectedAt pgtype.Timestamptz `json:"collected_at"`
+}
+
+func (q *Queries) GetEgg(ctx context.Context, id uuid.UUID) (GetEggRow, error) {
+	row := q.db.QueryRow(ctx, getEgg, id)
+	var i GetEggRow
+	err := row.Scan(
+		&i.InventoryID,
+		&i.PlayerID,
+		&i.Type,
+		&i.Hatched,
+		&i.Message,
+		&i.State,
+		&i.PlantedAt,
+		&i.IncubatesAt,
+		&i.ReadyAt,
+		&i.DecaysAt,
+		&i.CollectedAt,
+	)
+	return i, err
+}
+
+const getEggForCollect = `-- name: GetEggForCollect :one
+SELECT
+  i.player_id,
+  e.type,
+  e.state,
+  e.shielded,
+  e.decays_at,
+  e.collected_at,
+  ST_Distance(e.location::geography, ST_SetSRID(ST_MakePoint($1::float, $2::float), 4326)::geography)::float AS distance
+FROM eggs e
+JOIN inventory i ON i.id = e.inventory_id
+WHERE e.inventory_id = $3 AND e.location IS NOT NULL
+FOR UPDATE OF e, i
+`
+
+type GetEggForCollectParams struct {
+	Lon         float64   `json:"lon"`
+	Lat         float64   `json:"lat"`
+	InventoryID uuid.UUID `json:"inventory_id"`
+}
+
+type GetEggForCollectRow struct {
+	PlayerID    uuid.UUID          `json:"player_id"`
+	Type        string             `json:"type"`
+	State       string             `json:"state"`
+	Shielded    bool               `json:"shielded"`
+	DecaysAt    time.Time          `json:"decays_at"`
+	CollectedAt pgtype.Timestamptz `json:"collected_at"`
+	Distance    float64            `json:"distance"`
+}
+
+// Locks a dropped egg and its inventory row until the collecting
+// transaction ends, so concurrent collectors queue up behind each other
+func (q *Queries) GetEggForCollect(ctx context.Context, arg GetEggForCollectParams) (GetEggForCollectRow, error) {
+	row := q.db.QueryRow(ctx, getEggForCollect, arg.Lon, arg.Lat, arg.InventoryID)
+	var i GetEggForCollectRow
+	err := row.Scan(
+		&i.PlayerID,
+		&i.Type,
+		&i.State,
+		&i.Shielded,
+		&i.DecaysAt,
+		&i.CollectedAt,
+		&i.Distance,
+	)
+	return i, err
+}
+
 const getEggsByPlayer = `-- name: GetEggsByPlayer :many
-SELECT i.id AS inventory_id, e.type, e.hatched, e.message, e.collected_at
+SELECT i.id AS inventory_id, e.type, e.hatched, e.message, e.collected_at,
+       e.state, e.planted_at, e.incubates_at, e.ready_at, e.decays_at
 FROM inventory i
 JOIN eggs e ON e.inventory_id = i.id
 WHERE i.player_id = $1
 `
 
 type GetEggsByPlayerRow struct {
-	InventoryID uuid.UUID   `json:"inventory_id"`
-	Type        string      `json:"type"`
-	Hatched     pgtype.Bool `json:"hatched"`
-	Message     pgtype.Text `json:"message"`
-	CollectedAt time.Time   `json:"collected_at"`
+	InventoryID uuid.UUID          `json:"inventory_id"`
+	Type        string             `json:"type"`
+	Hatched     pgtype.Bool        `json:"hatched"`
+	Message     pgtype.Text        `json:"message"`
+	CollectedAt pgtype.Timestamptz `json:"collected_at"`
+	State       string             `json:"state"`
+	PlantedAt   time.Time          `json:"planted_at"`
+	IncubatesAt time.Time          `json:"incubates_at"`
+	ReadyAt     time.Time          `json:"ready_at"`
+	DecaysAt    time.Time          `json:"decays_at"`
 }
 
 func (q *Queries) GetEggsByPlayer(ctx context.Context, playerID uuid.UUID) ([]GetEggsByPlayerRow, error) {
@@ -106,6 +400,11 @@ func (q *Queries) GetEggsByPlayer(ctx context.Context, playerID uuid.UUID) ([]Ge
 			&i.Hatched,
 			&i.Message,
 			&i.CollectedAt,
+			&i.State,
+			&i.PlantedAt,
+			&i.IncubatesAt,
+			&i.ReadyAt,
+			&i.DecaysAt,
 		); err != nil {
 			return nil, err
 		}
@@ -150,6 +449,28 @@ func (q *Queries) GetInventoryByPlayer(ctx context.Context, playerID uuid.UUID)
 	return items, nil
 }
 
+const getPlayer = `-- name: GetPlayer :one
+SELECT id, account_id, coins, xp, level, settings, created_at, updated_at
+FROM players
+WHERE id = $1
+`
+
+func (q *Queries) GetPlayer(ctx context.Context, id uuid.UUID) (Players, error) {
+	row := q.db.QueryRow(ctx, getPlayer, id)
+	var i Players
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Coins,
+		&i.Xp,
+		&i.Level,
+		&i.Settings,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const getPlayerByAccount = `-- name: GetPlayerByAccount :one
 SELECT id, account_id, coins, xp, level, settings, created_at, updated_at
 FROM players
@@ -172,17 +493,42 @@ func (q *Queries) GetPlayerByAccount(ctx context.Context, accountID uuid.UUID) (
 	return i, err
 }
 
+const getPlayerDropStats = `-- name: GetPlayerDropStats :one
+SELECT
+  COUNT(*) FILTER (
+    WHERE e.state NOT IN ('HATCHED', 'DECAYED') AND e.decays_at > now() AND e.collected_at IS NULL
+  )::int AS active_eggs,
+  COALESCE(MAX(e.planted_at), 'epoch'::timestamptz)::timestamptz AS last_dropped_at
+FROM eggs e
+WHERE e.dropped_by = $1::uuid
+`
+
+type GetPlayerDropStatsRow struct {
+	ActiveEggs    int32     `json:"active_eggs"`
+	LastDroppedAt time.Time `json:"last_dropped_at"`
+}
+
+func (q *Queries) GetPlayerDropStats(ctx context.Context, playerID uuid.UUID) (GetPlayerDropStatsRow, error) {
+	row := q.db.QueryRow(ctx, getPlayerDropStats, playerID)
+	var i GetPlayerDropStatsRow
+	err := row.Scan(&i.ActiveEggs, &i.LastDroppedAt)
+	return i, err
+}
+
 const getToolsByPlayer = `-- name: GetToolsByPlayer :many
-SELECT i.id AS inventory_id, t.durability, t.equipped, i.description
+SELECT i.id AS inventory_id, t.kind, t.slot, t.durability, t.equipped, i.description
 FROM inventory i
 JOIN tools t ON t.inventory_id = i.id
 WHERE i.player_id = $1
+ORDER BY t.slot, i.created_at
 `
 
 type GetToolsByPlayerRow struct {
 	InventoryID uuid.UUID   `json:"inventory_id"`
+	Kind        string      `json:"kind"`
+	Slot        string      `json:"slot"`
 	Durability  int32       `json:"durability"`
-	Equipped    pgtype.Bool `json:"equipped"`
+	Equipped    bool        `json:"equipped"`
 	Description pgtype.Text `json:"description"`
 }
 
@@ -197,6 +543,8 @@ func (q *Queries) GetToolsByPlayer(ctx context.Context, playerID uuid.UUID) ([]G
 		var i GetToolsByPlayerRow
 		if err := rows.Scan(
 			&i.InventoryID,
+			&i.Kind,
+			&i.Slot,
 			&i.Durability,
 			&i.Equipped,
 			&i.Description,
@@ -211,12 +559,289 @@ func (q *Queries) GetToolsByPlayer(ctx context.Context, playerID uuid.UUID) ([]G
 	return items, nil
 }
 
+const hasEggWithin = `-- name: HasEggWithin :one
+SELECT EXISTS (
+  SELECT 1
+  FROM eggs e
+  WHERE e.state NOT IN ('HATCHED', 'DECAYED')
+    AND e.decays_at > now()
+    AND e.collected_at IS NULL
+    AND ST_DWithin(e.location::geography, ST_SetSRID(ST_MakePoint($1::float, $2::float), 4326)::geography, $3::float)
+)::boolean AS exists
+`
+
+type HasEggWithinParams struct {
+	Lon      float64 `json:"lon"`
+	Lat      float64 `json:"lat"`
+	Distance float64 `json:"distance"`
+}
+
+func (q *Queries) HasEggWithin(ctx context.Context, arg HasEggWithinParams) (bool, error) {
+	row := q.db.QueryRow(ctx, hasEggWithin, arg.Lon, arg.Lat, arg.Distance)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const listEggsInBoundingBox = `-- name: ListEggsInBoundingBox :many
+SELECT
+  i.id AS inventory_id,
+  i.player_id AS owner_id,
+  a.username AS owner_username,
+  e.type,
+  e.hatched,
+  e.message,
+  ST_Y(shown.location)::float AS lat,
+  ST_X(shown.location)::float AS lon,
+  ST_Distance(shown.location::geography, ST_SetSRID(ST_MakePoint($1::float, $2::float), 4326)::geography)::float AS distance
+FROM eggs e
+JOIN inventory i ON i.id = e.inventory_id
+JOIN players p ON p.id = i.player_id
+JOIN accounts a ON a.id = p.account_id
+CROSS JOIN LATERAL (
+  SELECT CASE WHEN i.player_id = $3::uuid THEN e.location ELSE ST_SnapToGrid(e.location, $4::float) END AS location
+) shown
+WHERE e.location IS NOT NULL
+  AND e.state NOT IN ('HATCHED', 'DECAYED')
+  AND e.decays_at > now()
+  AND e.collected_at IS NULL
+  AND e.location && ST_Expand(ST_MakeEnvelope($5::float, $6::float, $7::float, $8::float, 4326), $4::float)
+  AND shown.location && ST_MakeEnvelope($5::float, $6::float, $7::float, $8::float, 4326)
+ORDER BY distance
+LIMIT $9::int OFFSET $10::int
+`
+
+type ListEggsInBoundingBoxParams struct {
+	Lon        float64   `json:"lon"`
+	Lat        float64   `json:"lat"`
+	PlayerID   uuid.UUID `json:"player_id"`
+	BlurCell   float64   `json:"blur_cell"`
+	Xmin       float64   `json:"xmin"`
+	Ymin       float64   `json:"ymin"`
+	Xmax       float64   `json:"xmax"`
+	Ymax       float64   `json:"ymax"`
+	PageLimit  int32     `json:"page_limit"`
+	PageOffset int32     `json:"page_offset"`
+}
+
+type ListEggsInBoundingBoxRow struct {
+	InventoryID   uuid.UUID   `json:"inventory_id"`
+	OwnerID       uuid.UUID   `json:"owner_id"`
+	OwnerUsername pgtype.Text `json:"owner_username"`
+	Type          string      `json:"type"`
+	Hatched       pgtype.Bool `json:"hatched"`
+	Message       pgtype.Text `json:"message"`
+	Lat           float64     `json:"lat"`
+	Lon           float64     `json:"lon"`
+	Distance      float64     `json:"distance"`
+}
+
+// Placed like ListEggsWithinRadius
+func (q *Queries) ListEggsInBoundingBox(ctx context.Context, arg ListEggsInBoundingBoxParams) ([]ListEggsInBoundingBoxRow, error) {
+	rows, err := q.db.Query(ctx, listEggsInBoundingBox,
+		arg.Lon,
+		arg.Lat,
+		arg.PlayerID,
+		arg.BlurCell,
+		arg.Xmin,
+		arg.Ymin,
+		arg.Xmax,
+		arg.Ymax,
+		arg.PageLimit,
+		arg.PageOffset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListEggsInBoundingBoxRow{}
+	for rows.Next() {
+		var i ListEggsInBoundingBoxRow
+		if err := rows.Scan(
+			&i.InventoryID,
+			&i.OwnerID,
+			&i.OwnerUsername,
+			&i.Type,
+			&i.Hatched,
+			&i.Message,
+			&i.Lat,
+			&i.Lon,
+			&i.Distance,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEggsWithinRadius = `-- name: ListEggsWithinRadius :many
+SELECT
+  i.id AS inventory_id,
+  i.player_id AS owner_id,
+  a.username AS owner_username,
+  e.type,
+  e.hatched,
+  e.message,
+  ST_Y(shown.location)::float AS lat,
+  ST_X(shown.location)::float AS lon,
+  ST_Distance(shown.location::geography, ST_SetSRID(ST_MakePoint($1::float, $2::float), 4326)::geography)::float AS distance
+FROM eggs e
+JOIN inventory i ON i.id = e.inventory_id
+JOIN players p ON p.id = i.player_id
+JOIN accounts a ON a.id = p.account_id
+CROSS JOIN LATERAL (
+  SELECT CASE WHEN i.player_id = $3::uuid THEN e.location ELSE ST_SnapToGrid(e.location, $4::float) END AS location
+) shown
+WHERE e.location IS NOT NULL
+  AND e.state NOT IN ('HATCHED', 'DECAYED')
+  AND e.decays_at > now()
+  AND e.collected_at IS NULL
+  AND ST_DWithin(e.location::geography, ST_SetSRID(ST_MakePoint($1::float, $2::float), 4326)::geography, $5::float + $6::float)
+  AND ST_DWithin(shown.location::geography, ST_SetSRID(ST_MakePoint($1::float, $2::float), 4326)::geography, $5::float)
+ORDER BY distance
+LIMIT $7::int OFFSET $8::int
+`
+
+type ListEggsWithinRadiusParams struct {
+	Lon        float64   `json:"lon"`
+	Lat        float64   `json:"lat"`
+	PlayerID   uuid.UUID `json:"player_id"`
+	BlurCell   float64   `json:"blur_cell"`
+	Radius     float64   `json:"radius"`
+	BlurMargin float64   `json:"blur_margin"`
+	PageLimit  int32     `json:"page_limit"`
+	PageOffset int32     `json:"page_offset"`
+}
+
+type ListEggsWithinRadiusRow struct {
+	InventoryID   uuid.UUID   `json:"inventory_id"`
+	OwnerID       uuid.UUID   `json:"owner_id"`
+	OwnerUsername pgtype.Text `json:"owner_username"`
+	Type          string      `json:"type"`
+	Hatched       pgtype.Bool `json:"hatched"`
+	Message       pgtype.Text `json:"message"`
+	Lat           float64     `json:"lat"`
+	Lon           float64     `json:"lon"`
+	Distance      float64     `json:"distance"`
+}
+
+// Other players' eggs are searched, placed and measured at the center of
+// their blur cell, only the player's own eggs at their exact position.
+func (q *Queries) ListEggsWithinRadius(ctx context.Context, arg ListEggsWithinRadiusParams) ([]ListEggsWithinRadiusRow, error) {
+	rows, err := q.db.Query(ctx, listEggsWithinRadius,
+		arg.Lon,
+		arg.Lat,
+		arg.PlayerID,
+		arg.BlurCell,
+		arg.Radius,
+		arg.BlurMargin,
+		arg.PageLimit,
+		arg.PageOffset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListEggsWithinRadiusRow{}
+	for rows.Next() {
+		var i ListEggsWithinRadiusRow
+		if err := rows.Scan(
+			&i.InventoryID,
+			&i.OwnerID,
+			&i.OwnerUsername,
+			&i.Type,
+			&i.Hatched,
+			&i.Message,
+			&i.Lat,
+			&i.Lon,
+			&i.Distance,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const transferInventoryItem = `-- name: TransferInventoryItem :one
+UPDATE inventory
+SET player_id = $1
+WHERE id = $2 AND player_id = $3
+RETURNING id, player_id, item_type, quantity, description, created_at
+`
+
+type TransferInventoryItemParams struct {
+	ToPlayerID   uuid.UUID `json:"to_player_id"`
+	ID           uuid.UUID `json:"id"`
+	FromPlayerID uuid.UUID `json:"from_player_id"`
+}
+
+func (q *Queries) TransferInventoryItem(ctx context.Context, arg TransferInventoryItemParams) (Inventory, error) {
+	row := q.db.QueryRow(ctx, transferInventoryItem, arg.ToPlayerID, arg.ID, arg.FromPlayerID)
+	var i Inventory
+	err := row.Scan(
+		&i.ID,
+		&i.PlayerID,
+		&i.ItemType,
+		&i.Quantity,
+		&i.Description,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const transitionEgg = `-- name: TransitionEgg :one
+WITH updated AS (
+  UPDATE eggs
+  SET state = $1::varchar,
+      hatched = ($1::varchar = 'HATCHED')
+  WHERE eggs.inventory_id = $2 AND eggs.state = $3::varchar
+  RETURNING eggs.inventory_id
+)
+INSERT INTO egg_transitions (inventory_id, from_state, to_state, transitioned_at)
+SELECT updated.inventory_id, $3::varchar, $1::varchar, $4::timestamptz
+FROM updated
+RETURNING id, inventory_id, from_state, to_state, transitioned_at
+`
+
+type TransitionEggParams struct {
+	ToState        string    `json:"to_state"`
+	InventoryID    uuid.UUID `json:"inventory_id"`
+	FromState      string    `json:"from_state"`
+	TransitionedAt time.Time `json:"transitioned_at"`
+}
+
+func (q *Queries) TransitionEgg(ctx context.Context, arg TransitionEggParams) (EggTransitions, error) {
+	row := q.db.QueryRow(ctx, transitionEgg,
+		arg.ToState,
+		arg.InventoryID,
+		arg.FromState,
+		arg.TransitionedAt,
+	)
+	var i EggTransitions
+	err := row.Scan(
+		&i.ID,
+		&i.InventoryID,
+		&i.FromState,
+		&i.ToState,
+		&i.TransitionedAt,
+	)
+	return i, err
+}
+
 const updatePlayerStats = `-- name: UpdatePlayerStats :one
 UPDATE players
 SET coins = coins + $2,
     xp = xp + $3,
     updated_at = now()
-WHERE id = $1
+WHERE id = $1 AND coins + $2 >= 0
 RETURNING id, account_id, coins, xp, level, settings, created_at, updated_at
 `
 
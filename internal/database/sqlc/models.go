@@ -31,12 +31,122 @@ type Accounts struct {
 	UpdatedAt    time.Time   `json:"updated_at"`
 }
 
-type Eggs struct {
+type Achievements struct {
+	Code        string      `json:"code"`
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Event       string      `json:"event"`
+	EggType     pgtype.Text `json:"egg_type"`
+	Metric      string      `json:"metric"`
+	Target      int64       `json:"target"`
+	RewardCoins int64       `json:"reward_coins"`
+	RewardXp    int64       `json:"reward_xp"`
+	SortOrder   int32       `json:"sort_order"`
+	Enabled     bool        `json:"enabled"`
+	CreatedAt   time.Time   `json:"created_at"`
+}
+
+type ActiveBoosts struct {
+	ID          int64     `json:"id"`
+	PlayerID    uuid.UUID `json:"player_id"`
+	Kind        string    `json:"kind"`
+	Multiplier  float64   `json:"multiplier"`
+	ActivatedAt time.Time `json:"activated_at"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+type Boosts struct {
+	InventoryID     uuid.UUID `json:"inventory_id"`
+	Kind            string    `json:"kind"`
+	Multiplier      float64   `json:"multiplier"`
+	DurationSeconds int32     `json:"duration_seconds"`
+}
+
+type Creatures struct {
 	InventoryID uuid.UUID   `json:"inventory_id"`
-	Hatched     pgtype.Bool `json:"hatched"`
-	Type        string      `json:"type"`
-	Message     pgtype.Text `json:"message"`
-	CollectedAt time.Time   `json:"collected_at"`
+	SpeciesID   int32       `json:"species_id"`
+	EggID       pgtype.UUID `json:"egg_id"`
+	Nickname    pgtype.Text `json:"nickname"`
+	HatchedAt   time.Time   `json:"hatched_at"`
+}
+
+type DailyRewardClaims struct {
+	PlayerID  uuid.UUID   `json:"player_id"`
+	ClaimedOn pgtype.Date `json:"claimed_on"`
+	Day       int32       `json:"day"`
+	Streak    int32       `json:"streak"`
+	ClaimedAt time.Time   `json:"claimed_at"`
+}
+
+type DailyRewards struct {
+	Day          int32       `json:"day"`
+	Coins        int64       `json:"coins"`
+	Xp           int64       `json:"xp"`
+	ShopItemCode pgtype.Text `json:"shop_item_code"`
+	Packs        int32       `json:"packs"`
+	CreatedAt    time.Time   `json:"created_at"`
+}
+
+type EggSpawns struct {
+	ID           uuid.UUID          `json:"id"`
+	EggType      string             `json:"egg_type"`
+	Location     interface{}        `json:"location"`
+	SpawnPointID pgtype.Int4        `json:"spawn_point_id"`
+	SpawnedAt    time.Time          `json:"spawned_at"`
+	ExpiresAt    time.Time          `json:"expires_at"`
+	CollectedAt  pgtype.Timestamptz `json:"collected_at"`
+	CollectedBy  pgtype.UUID        `json:"collected_by"`
+}
+
+type EggTransitions struct {
+	ID             int64     `json:"id"`
+	InventoryID    uuid.UUID `json:"inventory_id"`
+	FromState      string    `json:"from_state"`
+	ToState        string    `json:"to_state"`
+	TransitionedAt time.Time `json:"transitioned_at"`
+}
+
+type EggTypes struct {
+	Code              string    `json:"code"`
+	Name              string    `json:"name"`
+	Rarity            string    `json:"rarity"`
+	CoinCost          int64     `json:"coin_cost"`
+	IncubationSeconds int32     `json:"incubation_seconds"`
+	DecaySeconds      int32     `json:"decay_seconds"`
+	MinLevel          int32     `json:"min_level"`
+	HatchXp           int64     `json:"hatch_xp"`
+	HatchCoins        int64     `json:"hatch_coins"`
+	Enabled           bool      `json:"enabled"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+	RequiresUnlock    bool      `json:"requires_unlock"`
+	SpawnWeight       int32     `json:"spawn_weight"`
+}
+
+type Eggs struct {
+	InventoryID uuid.UUID          `json:"inventory_id"`
+	Hatched     pgtype.Bool        `json:"hatched"`
+	Type        string             `json:"type"`
+	Message     pgtype.Text        `json:"message"`
+	CollectedAt pgtype.Timestamptz `json:"collected_at"`
+	Location    interface{}        `json:"location"`
+	State       string             `json:"state"`
+	PlantedAt   time.Time          `json:"planted_at"`
+	IncubatesAt time.Time          `json:"incubates_at"`
+	ReadyAt     time.Time          `json:"ready_at"`
+	DecaysAt    time.Time          `json:"decays_at"`
+	Shielded    bool               `json:"shielded"`
+	CollectedBy pgtype.UUID        `json:"collected_by"`
+	DroppedBy   pgtype.UUID        `json:"dropped_by"`
+}
+
+type ExclusionZones struct {
+	ID          uuid.UUID   `json:"id"`
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Area        interface{} `json:"area"`
+	CreatedBy   pgtype.UUID `json:"created_by"`
+	CreatedAt   time.Time   `json:"created_at"`
 }
 
 type Inventory struct {
@@ -48,6 +158,99 @@ type Inventory struct {
 	CreatedAt   time.Time   `json:"created_at"`
 }
 
+type LeaderboardUpdates struct {
+	PlayerID uuid.UUID `json:"player_id"`
+	QueuedAt time.Time `json:"queued_at"`
+}
+
+type LedgerEntries struct {
+	ID             int64       `json:"id"`
+	TransactionID  uuid.UUID   `json:"transaction_id"`
+	Account        string      `json:"account"`
+	PlayerID       pgtype.UUID `json:"player_id"`
+	Currency       string      `json:"currency"`
+	Amount         int64       `json:"amount"`
+	Reason         string      `json:"reason"`
+	ReferenceType  string      `json:"reference_type"`
+	ReferenceID    string      `json:"reference_id"`
+	IdempotencyKey string      `json:"idempotency_key"`
+	CreatedAt      time.Time   `json:"created_at"`
+}
+
+type LevelRewards struct {
+	Level        int32       `json:"level"`
+	Coins        int64       `json:"coins"`
+	ShopItemCode pgtype.Text `json:"shop_item_code"`
+	Packs        int32       `json:"packs"`
+	EggType      pgtype.Text `json:"egg_type"`
+	CreatedAt    time.Time   `json:"created_at"`
+}
+
+type LocationIncidents struct {
+	ID             int64              `json:"id"`
+	PlayerID       uuid.UUID          `json:"player_id"`
+	Source         string             `json:"source"`
+	Location       interface{}        `json:"location"`
+	AccuracyMeters pgtype.Float8      `json:"accuracy_meters"`
+	Score          int32              `json:"score"`
+	Action         string             `json:"action"`
+	Signals        []byte             `json:"signals"`
+	HoldUntil      pgtype.Timestamptz `json:"hold_until"`
+	CreatedAt      time.Time          `json:"created_at"`
+	Review         pgtype.Text        `json:"review"`
+	ReviewNote     pgtype.Text        `json:"review_note"`
+	ReviewedBy     pgtype.UUID        `json:"reviewed_by"`
+	ReviewedAt     pgtype.Timestamptz `json:"reviewed_at"`
+}
+
+type LoginStreaks struct {
+	PlayerID     uuid.UUID   `json:"player_id"`
+	Current      int32       `json:"current"`
+	Longest      int32       `json:"longest"`
+	LastActiveOn pgtype.Date `json:"last_active_on"`
+	Freezes      int32       `json:"freezes"`
+	UpdatedAt    time.Time   `json:"updated_at"`
+}
+
+type PlayerAchievements struct {
+	PlayerID        uuid.UUID          `json:"player_id"`
+	AchievementCode string             `json:"achievement_code"`
+	Progress        int64              `json:"progress"`
+	UnlockedAt      pgtype.Timestamptz `json:"unlocked_at"`
+	ClaimedAt       pgtype.Timestamptz `json:"claimed_at"`
+	UpdatedAt       time.Time          `json:"updated_at"`
+}
+
+type PlayerDistances struct {
+	PlayerID uuid.UUID   `json:"player_id"`
+	Day      pgtype.Date `json:"day"`
+	Meters   float64     `json:"meters"`
+}
+
+type PlayerEggTypes struct {
+	PlayerID   uuid.UUID `json:"player_id"`
+	EggType    string    `json:"egg_type"`
+	UnlockedAt time.Time `json:"unlocked_at"`
+}
+
+type PlayerLocations struct {
+	ID             int64         `json:"id"`
+	PlayerID       uuid.UUID     `json:"player_id"`
+	Location       interface{}   `json:"location"`
+	AccuracyMeters pgtype.Float8 `json:"accuracy_meters"`
+	RecordedAt     time.Time     `json:"recorded_at"`
+}
+
+type PlayerQuests struct {
+	PlayerID     uuid.UUID          `json:"player_id"`
+	TemplateCode string             `json:"template_code"`
+	PeriodStart  pgtype.Date        `json:"period_start"`
+	Progress     int64              `json:"progress"`
+	CompletedAt  pgtype.Timestamptz `json:"completed_at"`
+	ClaimedAt    pgtype.Timestamptz `json:"claimed_at"`
+	UpdatedAt    time.Time          `json:"updated_at"`
+}
+
 type Players struct {
 	ID        uuid.UUID `json:"id"`
 	AccountID uuid.UUID `json:"account_id"`
@@ -59,6 +262,33 @@ type Players struct {
 	UpdatedAt time.Time `json:"updated_at"`
 }
 
+type Purchases struct {
+	ID           uuid.UUID   `json:"id"`
+	PlayerID     uuid.UUID   `json:"player_id"`
+	ShopItemID   int32       `json:"shop_item_id"`
+	RequestKey   string      `json:"request_key"`
+	Packs        int32       `json:"packs"`
+	Price        int64       `json:"price"`
+	InventoryIds []uuid.UUID `json:"inventory_ids"`
+	CreatedAt    time.Time   `json:"created_at"`
+}
+
+type QuestTemplates struct {
+	Code        string      `json:"code"`
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Period      string      `json:"period"`
+	Event       string      `json:"event"`
+	EggType     pgtype.Text `json:"egg_type"`
+	Metric      string      `json:"metric"`
+	Target      int64       `json:"target"`
+	RewardCoins int64       `json:"reward_coins"`
+	RewardXp    int64       `json:"reward_xp"`
+	Weight      int32       `json:"weight"`
+	Enabled     bool        `json:"enabled"`
+	CreatedAt   time.Time   `json:"created_at"`
+}
+
 type Session struct {
 	ID           uuid.UUID `json:"id"`
 	AccountID    uuid.UUID `json:"account_id"`
@@ -70,8 +300,49 @@ type Session struct {
 	CreatedAt    time.Time `json:"created_at"`
 }
 
+type ShopItems struct {
+	ID                   int32         `json:"id"`
+	Code                 string        `json:"code"`
+	Name                 string        `json:"name"`
+	Description          string        `json:"description"`
+	ItemType             string        `json:"item_type"`
+	Price                int64         `json:"price"`
+	Quantity             int32         `json:"quantity"`
+	MinLevel             int32         `json:"min_level"`
+	ToolKind             pgtype.Text   `json:"tool_kind"`
+	BoostKind            pgtype.Text   `json:"boost_kind"`
+	BoostMultiplier      pgtype.Float8 `json:"boost_multiplier"`
+	BoostDurationSeconds pgtype.Int4   `json:"boost_duration_seconds"`
+	EggType              pgtype.Text   `json:"egg_type"`
+	Enabled              bool          `json:"enabled"`
+	CreatedAt            time.Time     `json:"created_at"`
+}
+
+type SpawnPoints struct {
+	ID           int32       `json:"id"`
+	Name         string      `json:"name"`
+	Location     interface{} `json:"location"`
+	RadiusMeters float64     `json:"radius_meters"`
+	MaxActive    int32       `json:"max_active"`
+	Enabled      bool        `json:"enabled"`
+	CreatedAt    time.Time   `json:"created_at"`
+}
+
+type Species struct {
+	ID          int32       `json:"id"`
+	Code        string      `json:"code"`
+	Name        string      `json:"name"`
+	EggType     string      `json:"egg_type"`
+	Rarity      string      `json:"rarity"`
+	Weight      int32       `json:"weight"`
+	Description pgtype.Text `json:"description"`
+	CreatedAt   time.Time   `json:"created_at"`
+}
+
 type Tools struct {
-	InventoryID uuid.UUID   `json:"inventory_id"`
-	Durability  int32       `json:"durability"`
-	Equipped    pgtype.Bool `json:"equipped"`
+	InventoryID uuid.UUID `json:"inventory_id"`
+	Durability  int32     `json:"durability"`
+	Equipped    bool      `json:"equipped"`
+	Kind        string    `json:"kind"`
+	Slot        string    `json:"slot"`
 }
@@ -0,0 +1,63 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	// maxTxAttempts is how many times ExecTx runs a transaction that keeps
+	// failing with a serialization failure before giving up
+	maxTxAttempts = 5
+	txRetryDelay  = 20 * time.Millisecond
+)
+
+// ExecTx executes fn within a serializable database transaction.
+// When postgres aborts the transaction with a serialization failure or a
+// deadlock, the whole transaction (including fn) is retried, so fn must only
+// touch state through the given *Queries and reset anything it captures.
+func (s *Service) ExecTx(ctx context.Context, fn func(*Queries) error) error {
+	var err error
+	for attempt := 1; attempt <= maxTxAttempts; attempt++ {
+		err = s.execTx(ctx, fn)
+		if !isRetryable(err) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Duration(attempt) * txRetryDelay):
+		}
+	}
+	return fmt.Errorf("transaction failed after %d attempts: %w", maxTxAttempts, err)
+}
+
+// execTx executes a function within a single database transaction
+func (s *Service) execTx(ctx context.Context, fn func(*Queries) error) error {
+	tx, err := s.connPool.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.Serializable})
+	if err != nil {
+		return err
+	}
+
+	q := New(tx)
+	if err := fn(q); err != nil {
+		if rbErr := tx.Rollback(ctx); rbErr != nil {
+			return fmt.Errorf("tx err: %w, rb err: %v", err, rbErr)
+		}
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func isRetryable(err error) bool {
+	switch ErrorCode(err) {
+	case SerializationFailure, DeadlockDetected:
+		return true
+	}
+	return false
+}
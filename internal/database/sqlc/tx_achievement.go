@@ -0,0 +1,74 @@
+package database
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+// ClaimAchievementTxResult is the result of ClaimAchievementTx
+type ClaimAchievementTxResult struct {
+	Achievement Achievements       `json:"achievement"`
+	Progress    PlayerAchievements `json:"progress"`
+	Player      Players            `json:"player"`
+	LevelUps    []LevelUp          `json:"level_ups"`
+}
+
+// ClaimAchievementTx marks an unlocked achievement claimed and pays its
+// reward in a single transaction. It returns ErrRecordNotFound for an unknown
+// or disabled achievement, ErrNotUnlocked when the player has not completed
+// it and ErrAlreadyClaimed when the reward was paid before.
+func (s *Service) ClaimAchievementTx(ctx context.Context, playerID uuid.UUID, code string) (ClaimAchievementTxResult, error) {
+	var result ClaimAchievementTxResult
+
+	err := s.ExecTx(ctx, func(q *Queries) error {
+		var err error
+		result = ClaimAchievementTxResult{}
+
+		result.Achievement, err = q.GetAchievement(ctx, code)
+		if err != nil {
+			return err
+		}
+		if !result.Achievement.Enabled {
+			return ErrRecordNotFound
+		}
+
+		arg := ClaimAchievementParams{
+			PlayerID:        playerID,
+			AchievementCode: code,
+		}
+		result.Progress, err = q.ClaimAchievement(ctx, arg)
+		if errors.Is(err, ErrRecordNotFound) {
+			progress, err := q.GetPlayerAchievement(ctx, GetPlayerAchievementParams(arg))
+			switch {
+			case errors.Is(err, ErrRecordNotFound), err == nil && !progress.UnlockedAt.Valid:
+				return ErrNotUnlocked
+			case err != nil:
+				return err
+			}
+			return ErrAlreadyClaimed
+		}
+		if err != nil {
+			return err
+		}
+
+		posted, err := s.postLedger(ctx, q, LedgerPosting{
+			PlayerID:       playerID,
+			Coins:          result.Achievement.RewardCoins,
+			Xp:             result.Achievement.RewardXp,
+			Reason:         ReasonAchievement,
+			ReferenceType:  "achievement",
+			ReferenceID:    code,
+			IdempotencyKey: "achievement:" + playerID.String() + ":" + code,
+		})
+		if err != nil {
+			return err
+		}
+		result.Player = posted.Player
+		result.LevelUps = posted.LevelUps
+		return nil
+	})
+
+	return result, err
+}
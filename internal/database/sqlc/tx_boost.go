@@ -0,0 +1,62 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ActivateBoostTxParams contains the input of ActivateBoostTx
+type ActivateBoostTxParams struct {
+	PlayerID    uuid.UUID
+	BoostID     uuid.UUID
+	ActivatedAt time.Time
+}
+
+// ActivateBoostTxResult is the result of ActivateBoostTx
+type ActivateBoostTxResult struct {
+	Active    ActiveBoosts `json:"active"`
+	Remaining int32        `json:"remaining"` // boosts left in the stack
+}
+
+// ActivateBoostTx consumes one boost of a player's stack and records it as
+// active until its duration runs out, in a single transaction. An emptied
+// stack is removed from the inventory. It returns ErrRecordNotFound when
+// the boost does not exist, belongs to another player or is used up.
+func (s *Service) ActivateBoostTx(ctx context.Context, arg ActivateBoostTxParams) (ActivateBoostTxResult, error) {
+	var result ActivateBoostTxResult
+
+	err := s.ExecTx(ctx, func(q *Queries) error {
+		boost, err := q.GetBoost(ctx, arg.BoostID)
+		if err != nil {
+			return err
+		}
+		if boost.PlayerID != arg.PlayerID {
+			return ErrRecordNotFound
+		}
+
+		item, err := q.ConsumeInventoryItem(ctx, arg.BoostID)
+		if err != nil {
+			return err
+		}
+		result.Remaining = item.Quantity
+
+		if item.Quantity == 0 {
+			if err := q.DeleteInventoryItem(ctx, item.ID); err != nil {
+				return err
+			}
+		}
+
+		result.Active, err = q.CreateActiveBoost(ctx, CreateActiveBoostParams{
+			PlayerID:    arg.PlayerID,
+			Kind:        boost.Kind,
+			Multiplier:  boost.Multiplier,
+			ActivatedAt: arg.ActivatedAt,
+			ExpiresAt:   arg.ActivatedAt.Add(time.Duration(boost.DurationSeconds) * time.Second),
+		})
+		return err
+	})
+
+	return result, err
+}
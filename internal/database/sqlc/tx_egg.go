@@ -0,0 +1,314 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/0xdbb/eggsplore/internal/game"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DropEggTxParams contains the input of DropEggTx.
+// Egg.InventoryID and Event.Value are filled in by the transaction.
+type DropEggTxParams struct {
+	PlayerID uuid.UUID
+	Egg      AddEggDetailsParams
+	Cost     int64      // coins charged for the drop
+	Tool     *ToolUse   // equipped shield protecting the egg, if any
+	Event    game.Event // valued with the meters to the farthest other egg dropped
+
+	// Check runs first inside the transaction, so the drop rules see the
+	// same data the drop is written against. A non-nil error aborts the drop.
+	Check func(q *Queries) error
+}
+
+// DropEggTxResult is the result of DropEggTx
+type DropEggTxResult struct {
+	Player    Players          `json:"player"`
+	Inventory Inventory        `json:"inventory"`
+	Egg       AddEggDetailsRow `json:"egg"`
+	Tool      *WornTool        `json:"tool"`
+	Goals     EventResult      `json:"goals"`
+}
+
+// DropEggTx charges the drop cost, creates the egg inventory row and its
+// details and records the drop event in a single transaction. It returns
+// ErrInsufficientCoins when the player cannot afford the drop.
+func (s *Service) DropEggTx(ctx context.Context, arg DropEggTxParams) (DropEggTxResult, error) {
+	var result DropEggTxResult
+
+	err := s.ExecTx(ctx, func(q *Queries) error {
+		var err error
+		result = DropEggTxResult{}
+
+		if arg.Check != nil {
+			if err = arg.Check(q); err != nil {
+				return err
+			}
+		}
+
+		// Measured before the egg exists, so only other eggs count
+		farthest, err := q.GetFarthestDropDistance(ctx, GetFarthestDropDistanceParams{
+			Lon:      arg.Egg.Lon,
+			Lat:      arg.Egg.Lat,
+			PlayerID: arg.PlayerID,
+		})
+		if err != nil {
+			return err
+		}
+		arg.Event.Value = int64(farthest)
+
+		result.Inventory, err = q.CreateEgg(ctx, CreateEggParams{
+			PlayerID:    arg.PlayerID,
+			Description: arg.Egg.Message,
+		})
+		if err != nil {
+			return err
+		}
+
+		posted, err := s.postLedger(ctx, q, LedgerPosting{
+			PlayerID:       arg.PlayerID,
+			Coins:          -arg.Cost,
+			Reason:         ReasonEggDrop,
+			ReferenceType:  "egg",
+			ReferenceID:    result.Inventory.ID.String(),
+			IdempotencyKey: "egg-drop:" + result.Inventory.ID.String(),
+		})
+		if err != nil {
+			return err
+		}
+		result.Player = posted.Player
+
+		egg := arg.Egg
+		if arg.Tool != nil {
+			worn, err := useTool(ctx, q, *arg.Tool)
+			if err != nil {
+				return err
+			}
+			result.Tool = &worn
+			egg.Shielded = true
+		}
+
+		egg.InventoryID = result.Inventory.ID
+		result.Egg, err = q.AddEggDetails(ctx, egg)
+		if err != nil {
+			return err
+		}
+
+		result.Goals, err = recordEvent(ctx, q, arg.PlayerID, arg.Event)
+		return err
+	})
+
+	return result, err
+}
+
+// HatchEggTxParams contains the input of HatchEggTx
+type HatchEggTxParams struct {
+	PlayerID  uuid.UUID
+	EggID     uuid.UUID
+	FromState string // state the egg was read in, must allow hatching
+	HatchedAt time.Time
+	Coins     int64
+	Xp        int64
+	Species   *Species // rolled species, nil when the egg type has none
+	Tool      *ToolUse // equipped shovel used to dig the egg out, if any
+	Event     game.Event
+}
+
+// HatchEggTxResult is the result of HatchEggTx
+type HatchEggTxResult struct {
+	Transition   EggTransitions `json:"transition"`
+	Player       Players        `json:"player"`
+	CreatureItem *Inventory     `json:"creature_item"`
+	Creature     *Creatures     `json:"creature"`
+	Tool         *WornTool      `json:"tool"`
+	LevelUps     []LevelUp      `json:"level_ups"`
+	Goals        EventResult    `json:"goals"`
+}
+
+// HatchEggTx marks the egg hatched, awards the player, adds the hatched
+// creature to their collection and records the hatch event in a single
+// transaction. It returns ErrRecordNotFound when the egg is no longer in
+// FromState.
+func (s *Service) HatchEggTx(ctx context.Context, arg HatchEggTxParams) (HatchEggTxResult, error) {
+	var result HatchEggTxResult
+
+	err := s.ExecTx(ctx, func(q *Queries) error {
+		var err error
+		result = HatchEggTxResult{}
+
+		result.Transition, err = q.TransitionEgg(ctx, TransitionEggParams{
+			ToState:        "HATCHED",
+			InventoryID:    arg.EggID,
+			FromState:      arg.FromState,
+			TransitionedAt: arg.HatchedAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		posted, err := s.postLedger(ctx, q, LedgerPosting{
+			PlayerID:       arg.PlayerID,
+			Coins:          arg.Coins,
+			Xp:             arg.Xp,
+			Reason:         ReasonHatchReward,
+			ReferenceType:  "egg",
+			ReferenceID:    arg.EggID.String(),
+			IdempotencyKey: "hatch:" + arg.EggID.String(),
+		})
+		if err != nil {
+			return err
+		}
+		result.Player = posted.Player
+		result.LevelUps = posted.LevelUps
+
+		if arg.Tool != nil {
+			worn, err := useTool(ctx, q, *arg.Tool)
+			if err != nil {
+				return err
+			}
+			result.Tool = &worn
+		}
+
+		result.Goals, err = recordEvent(ctx, q, arg.PlayerID, arg.Event)
+		if err != nil {
+			return err
+		}
+
+		if arg.Species == nil {
+			return nil
+		}
+
+		item, err := q.CreateCreature(ctx, CreateCreatureParams{
+			PlayerID:    arg.PlayerID,
+			Description: pgtype.Text{String: arg.Species.Name, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+
+		creature, err := q.AddCreatureDetails(ctx, AddCreatureDetailsParams{
+			InventoryID: item.ID,
+			SpeciesID:   arg.Species.ID,
+			EggID:       pgtype.UUID{Bytes: arg.EggID, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+
+		result.CreatureItem = &item
+		result.Creature = &creature
+		return nil
+	})
+
+	return result, err
+}
+
+// CollectEggTxParams contains the input of CollectEggTx
+type CollectEggTxParams struct {
+	FinderID     uuid.UUID
+	EggID        uuid.UUID
+	Lat          float64 // finder's position
+	Lon          float64
+	CollectedAt  time.Time
+	FinderCoins  int64
+	FinderXp     int64
+	DropperCoins int64
+	DropperXp    int64
+	Event        game.Event // raised for the finder
+
+	// Check runs on the locked egg before anything is written. A non-nil
+	// error aborts the collect.
+	Check func(egg GetEggForCollectRow) error
+}
+
+// CollectEggTxResult is the result of CollectEggTx
+type CollectEggTxResult struct {
+	Egg       CollectEggRow `json:"egg"`
+	Inventory Inventory     `json:"inventory"`
+	DropperID uuid.UUID     `json:"dropper_id"`
+	Player    Players       `json:"player"`    // the finder after the reward
+	LevelUps  []LevelUp     `json:"level_ups"` // levels the finder reached
+	Goals     EventResult   `json:"goals"`     // goals the finder completed
+}
+
+// CollectEggTx moves an egg another player dropped into the finder's
+// inventory, marks it collected, rewards both players and records the
+// finder's event in a single transaction. The egg row stays locked from the
+// check to the commit, so of two players collecting the same egg only the
+// first one succeeds.
+func (s *Service) CollectEggTx(ctx context.Context, arg CollectEggTxParams) (CollectEggTxResult, error) {
+	var result CollectEggTxResult
+
+	err := s.ExecTx(ctx, func(q *Queries) error {
+		var err error
+		result = CollectEggTxResult{}
+
+		egg, err := q.GetEggForCollect(ctx, GetEggForCollectParams{
+			Lon:         arg.Lon,
+			Lat:         arg.Lat,
+			InventoryID: arg.EggID,
+		})
+		if err != nil {
+			return err
+		}
+		if arg.Check != nil {
+			if err = arg.Check(egg); err != nil {
+				return err
+			}
+		}
+		result.DropperID = egg.PlayerID
+
+		result.Inventory, err = q.TransferInventoryItem(ctx, TransferInventoryItemParams{
+			ToPlayerID:   arg.FinderID,
+			ID:           arg.EggID,
+			FromPlayerID: egg.PlayerID,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Egg, err = q.CollectEgg(ctx, CollectEggParams{
+			CollectedAt: arg.CollectedAt,
+			CollectedBy: arg.FinderID,
+			InventoryID: arg.EggID,
+		})
+		if err != nil {
+			return err
+		}
+
+		posted, err := s.postLedger(ctx, q, LedgerPosting{
+			PlayerID:       arg.FinderID,
+			Coins:          arg.FinderCoins,
+			Xp:             arg.FinderXp,
+			Reason:         ReasonCollect,
+			ReferenceType:  "egg",
+			ReferenceID:    arg.EggID.String(),
+			IdempotencyKey: "collect:" + arg.EggID.String(),
+		})
+		if err != nil {
+			return err
+		}
+		result.Player = posted.Player
+		result.LevelUps = posted.LevelUps
+
+		_, err = s.postLedger(ctx, q, LedgerPosting{
+			PlayerID:       egg.PlayerID,
+			Coins:          arg.DropperCoins,
+			Xp:             arg.DropperXp,
+			Reason:         ReasonFindersFee,
+			ReferenceType:  "egg",
+			ReferenceID:    arg.EggID.String(),
+			IdempotencyKey: "collect-dropper:" + arg.EggID.String(),
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Goals, err = recordEvent(ctx, q, arg.FinderID, arg.Event)
+		return err
+	})
+
+	return result, err
+}
@@ -0,0 +1,104 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/0xdbb/eggsplore/internal/game"
+	"github.com/google/uuid"
+)
+
+// testEgg drops a free egg of the player at lat/lon, ready to hatch
+func testEgg(t *testing.T, s *Service, playerID uuid.UUID, lat, lon float64) uuid.UUID {
+	t.Helper()
+	now := time.Now()
+	result, err := s.DropEggTx(context.Background(), DropEggTxParams{
+		PlayerID: playerID,
+		Egg: AddEggDetailsParams{
+			Type:        "BUNNY",
+			Lat:         lat,
+			Lon:         lon,
+			PlantedAt:   now,
+			IncubatesAt: now,
+			ReadyAt:     now,
+			DecaysAt:    now.Add(time.Hour),
+		},
+		Event: game.Event{Kind: game.EventEggDropped, EggType: "BUNNY", At: now},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return result.Inventory.ID
+}
+
+func TestCollectEggTxOnce(t *testing.T) {
+	s := testService(t)
+	ctx := context.Background()
+	dropper := testPlayer(t, s, 0)
+	finders := []Players{testPlayer(t, s, 0), testPlayer(t, s, 0)}
+	eggID := testEgg(t, s, dropper.ID, 52.52, 13.405)
+
+	// Both finders collect at once, the row lock lets only the first through
+	errCollected := errors.New("already collected")
+	type outcome struct {
+		finder uuid.UUID
+		err    error
+	}
+	outcomes := make(chan outcome, len(finders))
+	for _, finder := range finders {
+		go func() {
+			_, err := s.CollectEggTx(ctx, CollectEggTxParams{
+				FinderID:     finder.ID,
+				EggID:        eggID,
+				Lat:          52.52,
+				Lon:          13.405,
+				CollectedAt:  time.Now(),
+				FinderCoins:  10,
+				DropperCoins: 5,
+				Event:        game.Event{Kind: game.EventEggCollected, EggType: "BUNNY", At: time.Now()},
+				Check: func(locked GetEggForCollectRow) error {
+					if locked.CollectedAt.Valid {
+						return errCollected
+					}
+					return nil
+				},
+			})
+			outcomes <- outcome{finder.ID, err}
+		}()
+	}
+
+	var winner uuid.UUID
+	for range finders {
+		o := <-outcomes
+		switch {
+		case o.err == nil && winner == uuid.Nil:
+			winner = o.finder
+		case o.err == nil:
+			t.Error("both finders collected the egg")
+		case !errors.Is(o.err, errCollected):
+			t.Errorf("CollectEggTx() = %v, want %v for the second finder", o.err, errCollected)
+		}
+	}
+	if winner == uuid.Nil {
+		t.Fatal("no finder collected the egg")
+	}
+
+	// The egg moved once and the dropper was paid once
+	items, err := s.GetInventoryByPlayer(ctx, winner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.ContainsFunc(items, func(i Inventory) bool { return i.ID == eggID }) {
+		t.Errorf("egg is not in the inventory of the finder %s", winner)
+	}
+	got, err := s.GetPlayer(ctx, dropper.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Coins != 5 {
+		t.Errorf("dropper has %d coins, want a single finder's fee of 5", got.Coins)
+	}
+}
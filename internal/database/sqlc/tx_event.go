@@ -0,0 +1,55 @@
+package database
+
+import (
+	"context"
+
+	"github.com/0xdbb/eggsplore/internal/game"
+	"github.com/google/uuid"
+)
+
+// EventResult is what recording an event completed
+type EventResult struct {
+	Unlocked  []Achievements `json:"unlocked"`  // achievements the event completed
+	Completed []ActiveQuest  `json:"completed"` // quests the event completed
+}
+
+// recordEvent advances the player's progress on every achievement and
+// active quest that follows the event and queues the player's leaderboard
+// update. It runs in the transaction of the action that raised the event, so
+// both are stored if and only if the action is.
+func recordEvent(ctx context.Context, q *Queries, playerID uuid.UUID, event game.Event) (EventResult, error) {
+	result := EventResult{Unlocked: []Achievements{}}
+
+	// Eggs hatched and drop distances are leaderboard scores
+	if err := q.QueueLeaderboardUpdate(ctx, playerID); err != nil {
+		return result, err
+	}
+
+	achievements, err := q.ListAchievementsByEvent(ctx, ListAchievementsByEventParams{
+		Event:   string(event.Kind),
+		EggType: event.EggType,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	for _, a := range achievements {
+		amount, keepMax := game.GoalMetric(a.Metric).Advance(event)
+		progress, err := q.AdvanceAchievement(ctx, AdvanceAchievementParams{
+			PlayerID:        playerID,
+			AchievementCode: a.Code,
+			Amount:          amount,
+			Target:          a.Target,
+			KeepMax:         keepMax,
+		})
+		if err != nil {
+			return result, err
+		}
+		if progress.JustUnlocked {
+			result.Unlocked = append(result.Unlocked, a)
+		}
+	}
+
+	result.Completed, err = advanceQuests(ctx, q, playerID, event)
+	return result, err
+}
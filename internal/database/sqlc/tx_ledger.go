@@ -0,0 +1,192 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Reasons recorded on ledger entries
+const (
+	ReasonStarterKit   = "STARTER_KIT"
+	ReasonEggDrop      = "EGG_DROP"
+	ReasonHatchReward  = "HATCH_REWARD"
+	ReasonShopPurchase = "SHOP_PURCHASE"
+	ReasonLevelUp      = "LEVEL_UP"
+	ReasonAchievement  = "ACHIEVEMENT_REWARD"
+	ReasonQuest        = "QUEST_REWARD"
+	ReasonDailyReward  = "DAILY_REWARD"
+	ReasonCollect      = "COLLECT_REWARD"
+	ReasonFindersFee   = "FINDERS_FEE" // paid to the dropper of a collected egg
+)
+
+// Currencies a ledger entry can move
+const (
+	CurrencyCoin = "COIN"
+	CurrencyXP   = "XP"
+)
+
+// LedgerPosting is a change of a player's coins and XP. Positive amounts are
+// credited to the player, negative ones debited.
+type LedgerPosting struct {
+	PlayerID       uuid.UUID
+	Coins          int64
+	Xp             int64
+	Reason         string
+	ReferenceType  string // kind of entity the change is about, e.g., egg
+	ReferenceID    string
+	IdempotencyKey string // a posting with a key already in the ledger is not applied again
+}
+
+// PostLedgerTxResult is the result of PostLedgerTx
+type PostLedgerTxResult struct {
+	Player   Players   `json:"player"`
+	Applied  bool      `json:"applied"`   // false when the key was posted before
+	LevelUps []LevelUp `json:"level_ups"` // levels reached through the posted XP
+}
+
+// PostLedgerTx posts a single change of a player's balances in its own
+// transaction. It returns ErrInsufficientCoins when the player would end up
+// with negative coins.
+func (s *Service) PostLedgerTx(ctx context.Context, arg LedgerPosting) (PostLedgerTxResult, error) {
+	var result PostLedgerTxResult
+
+	err := s.ExecTx(ctx, func(q *Queries) error {
+		var err error
+		result, err = s.postLedger(ctx, q, arg)
+		return err
+	})
+
+	return result, err
+}
+
+// postLedger writes both legs of every non-zero currency in p and applies them
+// to the player row. It is the only place that changes players.coins and
+// players.xp, so the two never drift from the ledger. Posted XP that takes
+// the player to a new level grants that level's rewards.
+func (s *Service) postLedger(ctx context.Context, q *Queries, p LedgerPosting) (PostLedgerTxResult, error) {
+	var result PostLedgerTxResult
+
+	posted, err := q.LedgerKeyExists(ctx, p.IdempotencyKey)
+	if err != nil {
+		return result, err
+	}
+	if posted || (p.Coins == 0 && p.Xp == 0) {
+		result.Player, err = q.GetPlayer(ctx, p.PlayerID)
+		return result, err
+	}
+
+	result.Player, err = q.UpdatePlayerStats(ctx, UpdatePlayerStatsParams{
+		ID:    p.PlayerID,
+		Coins: p.Coins,
+		Xp:    p.Xp,
+	})
+	if err != nil {
+		if !errors.Is(err, ErrRecordNotFound) {
+			return result, err
+		}
+		// No row is updated either when the player cannot cover the
+		// posting or when there is no such player
+		if _, err := q.GetPlayer(ctx, p.PlayerID); err != nil {
+			return result, err
+		}
+		return result, ErrInsufficientCoins
+	}
+	result.Applied = true
+
+	transactionID := uuid.New()
+	changes := []struct {
+		currency string
+		amount   int64
+	}{
+		{CurrencyCoin, p.Coins},
+		{CurrencyXP, p.Xp},
+	}
+	for _, change := range changes {
+		if change.amount == 0 {
+			continue
+		}
+
+		legs := []CreateLedgerEntryParams{
+			{
+				Account:  "player:" + p.PlayerID.String(),
+				PlayerID: pgtype.UUID{Bytes: p.PlayerID, Valid: true},
+				Amount:   change.amount,
+			},
+			{
+				Account: "system:" + strings.ToLower(p.Reason),
+				Amount:  -change.amount,
+			},
+		}
+		for _, leg := range legs {
+			leg.TransactionID = transactionID
+			leg.Currency = change.currency
+			leg.Reason = p.Reason
+			leg.ReferenceType = p.ReferenceType
+			leg.ReferenceID = p.ReferenceID
+			leg.IdempotencyKey = p.IdempotencyKey
+			if _, err := q.CreateLedgerEntry(ctx, leg); err != nil {
+				return result, err
+			}
+		}
+	}
+
+	if p.Xp == 0 {
+		return result, nil
+	}
+
+	// XP earned is a leaderboard score
+	if err = q.QueueLeaderboardUpdate(ctx, p.PlayerID); err != nil {
+		return result, err
+	}
+
+	result.Player, result.LevelUps, err = s.levelUp(ctx, q, result.Player)
+	return result, err
+}
+
+// ReconcileLedgerTxResult is the result of ReconcileLedgerTx
+type ReconcileLedgerTxResult struct {
+	Mismatches []ListLedgerMismatchesRow             `json:"mismatches"`
+	Unbalanced []ListUnbalancedLedgerTransactionsRow `json:"unbalanced"`
+}
+
+// ReconcileLedgerTx compares every player's balances with the sum of their
+// ledger entries and lists ledger transactions whose legs do not sum to zero.
+// With apply set, mismatching balances are reset to what the ledger says and
+// the level is recomputed from the reset XP, without granting level rewards.
+func (s *Service) ReconcileLedgerTx(ctx context.Context, apply bool) (ReconcileLedgerTxResult, error) {
+	var result ReconcileLedgerTxResult
+
+	err := s.ExecTx(ctx, func(q *Queries) error {
+		var err error
+		result = ReconcileLedgerTxResult{}
+
+		result.Unbalanced, err = q.ListUnbalancedLedgerTransactions(ctx)
+		if err != nil {
+			return err
+		}
+
+		result.Mismatches, err = q.ListLedgerMismatches(ctx)
+		if err != nil || !apply {
+			return err
+		}
+
+		for _, m := range result.Mismatches {
+			_, err := q.SetPlayerBalances(ctx, SetPlayerBalancesParams{
+				Coins: m.LedgerCoins,
+				Xp:    m.LedgerXp,
+				Level: s.xpCurve.Level(m.LedgerXp),
+				ID:    m.PlayerID,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	return result, err
+}
@@ -0,0 +1,98 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestPostLedgerIdempotent(t *testing.T) {
+	s := testService(t)
+	ctx := context.Background()
+	player := testPlayer(t, s, 100)
+
+	posting := LedgerPosting{
+		PlayerID:       player.ID,
+		Coins:          25,
+		Xp:             10,
+		Reason:         ReasonDailyReward,
+		ReferenceType:  "test",
+		ReferenceID:    player.ID.String(),
+		IdempotencyKey: "test:" + uuid.NewString(),
+	}
+	for i, wantApplied := range []bool{true, false} {
+		result, err := s.PostLedgerTx(ctx, posting)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.Applied != wantApplied {
+			t.Errorf("posting %d applied = %v, want %v", i+1, result.Applied, wantApplied)
+		}
+		if result.Player.Coins != 125 || result.Player.Xp != 10 {
+			t.Errorf("posting %d left %d coins and %d XP, want 125 and 10", i+1, result.Player.Coins, result.Player.Xp)
+		}
+	}
+
+	entries, err := s.ListLedgerEntriesByPlayer(ctx, ListLedgerEntriesByPlayerParams{
+		PlayerID:  player.ID,
+		Currency:  CurrencyCoin,
+		PageLimit: 10,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 {
+		t.Errorf("%d coin entries, want the starter kit and one posting", len(entries))
+	}
+}
+
+func TestPostLedgerRejected(t *testing.T) {
+	s := testService(t)
+	ctx := context.Background()
+	player := testPlayer(t, s, 10)
+
+	tests := []struct {
+		name     string
+		playerID uuid.UUID
+		coins    int64
+		wantErr  error
+	}{
+		{"insufficient coins", player.ID, -20, ErrInsufficientCoins},
+		{"unknown player", uuid.New(), 20, ErrRecordNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := "test:" + uuid.NewString()
+			_, err := s.PostLedgerTx(ctx, LedgerPosting{
+				PlayerID:       tt.playerID,
+				Coins:          tt.coins,
+				Reason:         ReasonShopPurchase,
+				ReferenceType:  "test",
+				ReferenceID:    key,
+				IdempotencyKey: key,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("PostLedgerTx() = %v, want %v", err, tt.wantErr)
+			}
+
+			posted, err := s.LedgerKeyExists(ctx, key)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if posted {
+				t.Error("rejected posting is in the ledger")
+			}
+		})
+	}
+
+	got, err := s.GetPlayer(ctx, player.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Coins != 10 {
+		t.Errorf("coins = %d after rejected postings, want 10", got.Coins)
+	}
+}
@@ -0,0 +1,129 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/0xdbb/eggsplore/internal/game"
+	"github.com/google/uuid"
+)
+
+// LevelUp is a level a player reached and what it granted them
+type LevelUp struct {
+	Level        int32       `json:"level"`
+	Coins        int64       `json:"coins"`
+	InventoryIDs []uuid.UUID `json:"inventory_ids"` // items granted
+	EggType      string      `json:"egg_type,omitempty"`
+}
+
+// SetXPCurve sets the curve levels are computed from. It must be called
+// before the service is used, the default is game.DefaultXPCurve.
+func (s *Service) SetXPCurve(curve game.XPCurve) {
+	s.xpCurve = curve
+}
+
+// XPCurve returns the curve levels are computed from
+func (s *Service) XPCurve() game.XPCurve {
+	return s.xpCurve
+}
+
+// levelUp moves the player to the level their XP is at and grants the
+// rewards of every level they passed on the way.
+func (s *Service) levelUp(ctx context.Context, q *Queries, player Players) (Players, []LevelUp, error) {
+	from := player.Level
+	to := s.xpCurve.Level(player.Xp)
+	if to <= from {
+		return player, nil, nil
+	}
+
+	player, err := q.SetPlayerLevel(ctx, SetPlayerLevelParams{
+		ID:    player.ID,
+		Level: to,
+	})
+	if err != nil {
+		return player, nil, err
+	}
+
+	rewards, err := q.ListLevelRewards(ctx, ListLevelRewardsParams{
+		FromLevel: from,
+		ToLevel:   to,
+	})
+	if err != nil {
+		return player, nil, err
+	}
+	granted := make(map[int32]LevelUp, len(rewards))
+	for _, reward := range rewards {
+		levelUp, err := s.grantLevelReward(ctx, q, player.ID, reward)
+		if err != nil {
+			return player, nil, err
+		}
+		granted[reward.Level] = levelUp
+	}
+
+	levelUps := make([]LevelUp, 0, to-from)
+	for level := from + 1; level <= to; level++ {
+		levelUp, ok := granted[level]
+		if !ok {
+			levelUp = LevelUp{Level: level, InventoryIDs: []uuid.UUID{}}
+		}
+		levelUps = append(levelUps, levelUp)
+	}
+
+	// Reward coins went through the ledger after the level was set
+	if len(rewards) > 0 {
+		player, err = q.GetPlayer(ctx, player.ID)
+	}
+	return player, levelUps, err
+}
+
+// grantLevelReward grants the coins, item and egg type of a level reward
+func (s *Service) grantLevelReward(ctx context.Context, q *Queries, playerID uuid.UUID, reward LevelRewards) (LevelUp, error) {
+	levelUp := LevelUp{
+		Level:        reward.Level,
+		InventoryIDs: []uuid.UUID{},
+	}
+	level := strconv.Itoa(int(reward.Level))
+
+	if reward.Coins > 0 {
+		_, err := s.postLedger(ctx, q, LedgerPosting{
+			PlayerID:       playerID,
+			Coins:          reward.Coins,
+			Reason:         ReasonLevelUp,
+			ReferenceType:  "level",
+			ReferenceID:    level,
+			IdempotencyKey: "level-up:" + playerID.String() + ":" + level,
+		})
+		if err != nil {
+			return levelUp, err
+		}
+		levelUp.Coins = reward.Coins
+	}
+
+	if reward.ShopItemCode.Valid {
+		item, err := q.GetShopItemByCode(ctx, reward.ShopItemCode.String)
+		if err != nil {
+			return levelUp, err
+		}
+		ids, err := grantShopItem(ctx, q, playerID, item, reward.Packs)
+		switch {
+		case err == nil:
+			levelUp.InventoryIDs = ids
+		case !errors.Is(err, ErrAlreadyUnlocked):
+			return levelUp, err
+		}
+	}
+
+	if reward.EggType.Valid {
+		_, err := q.UnlockEggType(ctx, UnlockEggTypeParams{
+			PlayerID: playerID,
+			EggType:  reward.EggType.String,
+		})
+		if err != nil && !errors.Is(err, ErrRecordNotFound) {
+			return levelUp, err
+		}
+		levelUp.EggType = reward.EggType.String
+	}
+
+	return levelUp, nil
+}
@@ -0,0 +1,57 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// RecordLocationTxParams contains the input of RecordLocationTx
+type RecordLocationTxParams struct {
+	Location CreatePlayerLocationParams
+
+	// Travelled counts the way from the previous location, when it was
+	// recorded at most MaxGap before. Longer gaps are left out, the player
+	// was not tracked on the way.
+	Travelled bool
+	MaxGap    time.Duration
+}
+
+// RecordLocationTx stores a player's location and adds the way from their
+// previous one to the distance they travelled in a single transaction.
+func (s *Service) RecordLocationTx(ctx context.Context, arg RecordLocationTxParams) (CreatePlayerLocationRow, error) {
+	var result CreatePlayerLocationRow
+
+	err := s.ExecTx(ctx, func(q *Queries) error {
+		var err error
+		loc := arg.Location
+
+		previous, err := q.GetLastPlayerLocation(ctx, loc.PlayerID)
+		if err != nil && !errors.Is(err, ErrRecordNotFound) {
+			return err
+		}
+		counted := err == nil && arg.Travelled && loc.RecordedAt.Sub(previous.RecordedAt) <= arg.MaxGap
+
+		result, err = q.CreatePlayerLocation(ctx, loc)
+		if err != nil || !counted {
+			return err
+		}
+
+		err = q.AddDistanceTravelled(ctx, AddDistanceTravelledParams{
+			PlayerID:   loc.PlayerID,
+			RecordedAt: loc.RecordedAt,
+			FromLon:    previous.Lon,
+			FromLat:    previous.Lat,
+			ToLon:      loc.Lon,
+			ToLat:      loc.Lat,
+		})
+		if err != nil {
+			return err
+		}
+
+		// Distance travelled is a leaderboard score
+		return q.QueueLeaderboardUpdate(ctx, loc.PlayerID)
+	})
+
+	return result, err
+}
@@ -0,0 +1,96 @@
+package database
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// StarterKit is what every new player starts with
+type StarterKit struct {
+	Coins int64
+	Tools []string // kind of each starter tool, also used as its description
+}
+
+// CreateAccountTxParams contains the input of CreateAccountTx
+type CreateAccountTxParams struct {
+	CreateAccountParams
+	StarterKit StarterKit
+}
+
+// CreateAccountTxResult is the result of CreateAccountTx
+type CreateAccountTxResult struct {
+	Account Accounts `json:"account"`
+	Player  Players  `json:"player"`
+}
+
+// CreateAccountTx creates an account together with its player and starter kit
+// in a single transaction
+func (s *Service) CreateAccountTx(ctx context.Context, arg CreateAccountTxParams) (CreateAccountTxResult, error) {
+	var result CreateAccountTxResult
+
+	err := s.ExecTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Account, err = q.CreateAccount(ctx, arg.CreateAccountParams)
+		if err != nil {
+			return err
+		}
+
+		result.Player, err = s.provisionPlayer(ctx, q, result.Account.ID, arg.StarterKit)
+		return err
+	})
+
+	return result, err
+}
+
+// ProvisionPlayerTx creates the missing player of an existing account
+// together with its starter kit in a single transaction
+func (s *Service) ProvisionPlayerTx(ctx context.Context, accountID uuid.UUID, kit StarterKit) (Players, error) {
+	var player Players
+
+	err := s.ExecTx(ctx, func(q *Queries) error {
+		var err error
+		player, err = s.provisionPlayer(ctx, q, accountID, kit)
+		return err
+	})
+
+	return player, err
+}
+
+func (s *Service) provisionPlayer(ctx context.Context, q *Queries, accountID uuid.UUID, kit StarterKit) (Players, error) {
+	player, err := q.CreatePlayer(ctx, accountID)
+	if err != nil {
+		return player, err
+	}
+
+	posted, err := s.postLedger(ctx, q, LedgerPosting{
+		PlayerID:       player.ID,
+		Coins:          kit.Coins,
+		Reason:         ReasonStarterKit,
+		ReferenceType:  "player",
+		ReferenceID:    player.ID.String(),
+		IdempotencyKey: "starter-kit:" + player.ID.String(),
+	})
+	if err != nil {
+		return player, err
+	}
+	player = posted.Player
+
+	for _, tool := range kit.Tools {
+		inv, err := q.CreateTool(ctx, CreateToolParams{
+			PlayerID:    player.ID,
+			Description: pgtype.Text{String: tool, Valid: true},
+		})
+		if err != nil {
+			return player, err
+		}
+
+		if _, err := createToolDetails(ctx, q, inv.ID, tool); err != nil {
+			return player, err
+		}
+	}
+
+	return player, nil
+}
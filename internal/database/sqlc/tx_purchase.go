@@ -0,0 +1,157 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// PurchaseItemTxParams contains the input of PurchaseItemTx
+type PurchaseItemTxParams struct {
+	PlayerID   uuid.UUID
+	Item       ShopItems
+	Packs      int32  // how many times the item is bought
+	RequestKey string // retries with the same key return the first purchase
+}
+
+// PurchaseItemTxResult is the result of PurchaseItemTx
+type PurchaseItemTxResult struct {
+	Player   Players   `json:"player"`
+	Purchase Purchases `json:"purchase"`
+	Replayed bool      `json:"replayed"` // the key was used before, nothing new was charged
+}
+
+// PurchaseItemTx debits the price of a shop item from the player's coins and
+// creates the bought inventory rows in a single transaction.
+//
+// A request key that was already used for the same item and packs returns the
+// original purchase without charging again; used for anything else it returns
+// ErrRequestKeyReused. It returns ErrInsufficientCoins when the player cannot
+// afford the item and ErrAlreadyUnlocked for an egg type the player has.
+func (s *Service) PurchaseItemTx(ctx context.Context, arg PurchaseItemTxParams) (PurchaseItemTxResult, error) {
+	var result PurchaseItemTxResult
+
+	err := s.ExecTx(ctx, func(q *Queries) error {
+		var err error
+		result = PurchaseItemTxResult{}
+
+		previous, err := q.GetPurchaseByKey(ctx, GetPurchaseByKeyParams{
+			PlayerID:   arg.PlayerID,
+			RequestKey: arg.RequestKey,
+		})
+		switch {
+		case err == nil:
+			if previous.ShopItemID != arg.Item.ID || previous.Packs != arg.Packs {
+				return ErrRequestKeyReused
+			}
+			result.Purchase = previous
+			result.Replayed = true
+			result.Player, err = q.GetPlayer(ctx, arg.PlayerID)
+			return err
+		case !errors.Is(err, ErrRecordNotFound):
+			return err
+		}
+
+		price := arg.Item.Price * int64(arg.Packs)
+		inventoryIDs, err := grantShopItem(ctx, q, arg.PlayerID, arg.Item, arg.Packs)
+		if err != nil {
+			return err
+		}
+
+		result.Purchase, err = q.CreatePurchase(ctx, CreatePurchaseParams{
+			PlayerID:     arg.PlayerID,
+			ShopItemID:   arg.Item.ID,
+			RequestKey:   arg.RequestKey,
+			Packs:        arg.Packs,
+			Price:        price,
+			InventoryIds: inventoryIDs,
+		})
+		if err != nil {
+			return err
+		}
+
+		posted, err := s.postLedger(ctx, q, LedgerPosting{
+			PlayerID:       arg.PlayerID,
+			Coins:          -price,
+			Reason:         ReasonShopPurchase,
+			ReferenceType:  "purchase",
+			ReferenceID:    result.Purchase.ID.String(),
+			IdempotencyKey: "purchase:" + result.Purchase.ID.String(),
+		})
+		result.Player = posted.Player
+		return err
+	})
+
+	return result, err
+}
+
+// grantShopItem creates what packs of a shop item are made of and returns
+// the created inventory rows. Every tool gets its own row, since each wears
+// down separately, while boosts are stacked in one row. Egg types and streak
+// freezes are not inventory items and create no rows.
+func grantShopItem(ctx context.Context, q *Queries, playerID uuid.UUID, item ShopItems, packs int32) ([]uuid.UUID, error) {
+	units := item.Quantity * packs
+	description := pgtype.Text{String: item.Name, Valid: true}
+
+	switch item.ItemType {
+	case "TOOL":
+		ids := make([]uuid.UUID, 0, units)
+		for range units {
+			inv, err := q.CreateTool(ctx, CreateToolParams{
+				PlayerID:    playerID,
+				Description: description,
+			})
+			if err != nil {
+				return nil, err
+			}
+			if _, err := createToolDetails(ctx, q, inv.ID, item.ToolKind.String); err != nil {
+				return nil, err
+			}
+			ids = append(ids, inv.ID)
+		}
+		return ids, nil
+
+	case "BOOST":
+		inv, err := q.CreateInventoryItem(ctx, CreateInventoryItemParams{
+			PlayerID:    playerID,
+			ItemType:    "BOOST",
+			Quantity:    units,
+			Description: description,
+		})
+		if err != nil {
+			return nil, err
+		}
+		_, err = q.AddBoostDetails(ctx, AddBoostDetailsParams{
+			InventoryID:     inv.ID,
+			Kind:            item.BoostKind.String,
+			Multiplier:      item.BoostMultiplier.Float64,
+			DurationSeconds: item.BoostDurationSeconds.Int32,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return []uuid.UUID{inv.ID}, nil
+
+	case "EGG_TYPE":
+		_, err := q.UnlockEggType(ctx, UnlockEggTypeParams{
+			PlayerID: playerID,
+			EggType:  item.EggType.String,
+		})
+		if errors.Is(err, ErrRecordNotFound) {
+			return nil, ErrAlreadyUnlocked
+		}
+		return []uuid.UUID{}, err
+
+	case "STREAK_FREEZE":
+		_, err := q.AddStreakFreezes(ctx, AddStreakFreezesParams{
+			PlayerID: playerID,
+			Freezes:  units,
+		})
+		return []uuid.UUID{}, err
+	}
+
+	return nil, fmt.Errorf("unknown shop item type %q", item.ItemType)
+}
@@ -0,0 +1,68 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestPurchaseItemTxRequestKey(t *testing.T) {
+	s := testService(t)
+	ctx := context.Background()
+	player := testPlayer(t, s, 100)
+
+	radar, err := s.GetShopItemByCode(ctx, "RADAR")
+	if err != nil {
+		t.Fatal(err)
+	}
+	shovel, err := s.GetShopItemByCode(ctx, "SHOVEL")
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := uuid.NewString()
+
+	first, err := s.PurchaseItemTx(ctx, PurchaseItemTxParams{PlayerID: player.ID, Item: radar, Packs: 1, RequestKey: key})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.Replayed || first.Player.Coins != 100-radar.Price {
+		t.Fatalf("first purchase replayed %v with %d coins left, want a new one with %d", first.Replayed, first.Player.Coins, 100-radar.Price)
+	}
+
+	tests := []struct {
+		name    string
+		item    ShopItems
+		packs   int32
+		wantErr error
+	}{
+		{"replay", radar, 1, nil},
+		{"other packs", radar, 2, ErrRequestKeyReused},
+		{"other item", shovel, 1, ErrRequestKeyReused},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := s.PurchaseItemTx(ctx, PurchaseItemTxParams{PlayerID: player.ID, Item: tt.item, Packs: tt.packs, RequestKey: key})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("PurchaseItemTx() = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if !result.Replayed || result.Purchase.ID != first.Purchase.ID {
+				t.Errorf("replay returned purchase %s (replayed %v), want %s", result.Purchase.ID, result.Replayed, first.Purchase.ID)
+			}
+		})
+	}
+
+	// Only the first purchase was charged
+	got, err := s.GetPlayer(ctx, player.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Coins != 100-radar.Price {
+		t.Errorf("coins = %d, want %d charged once", got.Coins, 100-radar.Price)
+	}
+}
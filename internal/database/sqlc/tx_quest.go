@@ -0,0 +1,196 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/0xdbb/eggsplore/internal/game"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ActiveQuest is a quest handed to a player for the current period
+type ActiveQuest struct {
+	Template    QuestTemplates `json:"template"`
+	PeriodStart time.Time      `json:"period_start"` // local midnight the period started at
+	ResetsAt    time.Time      `json:"resets_at"`
+}
+
+// Date returns the date the quest's progress is stored under
+func (a ActiveQuest) Date() pgtype.Date {
+	return questDate(a.PeriodStart)
+}
+
+// questDate returns the local date a period starting at start is stored under
+func questDate(start time.Time) pgtype.Date {
+	return pgtype.Date{
+		Time:  time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC),
+		Valid: true,
+	}
+}
+
+// ActiveQuests returns the quests handed to the player for the periods
+// containing now, in the player's time zone
+func (s *Service) ActiveQuests(ctx context.Context, player Players, now time.Time) ([]ActiveQuest, error) {
+	return activeQuests(ctx, s.Queries, player, now)
+}
+
+// activeQuests picks the player's quests of every period from the enabled
+// templates. The pick is deterministic for a player and period, so it is
+// recomputed instead of stored; changing the template pool mid-period may
+// hand out different quests.
+func activeQuests(ctx context.Context, q *Queries, player Players, now time.Time) ([]ActiveQuest, error) {
+	loc := game.PlayerLocation(player.Settings)
+
+	active := []ActiveQuest{}
+	for _, period := range game.QuestPeriods {
+		templates, err := q.ListQuestTemplates(ctx, string(period))
+		if err != nil {
+			return nil, err
+		}
+
+		weights := make([]int32, len(templates))
+		for i, t := range templates {
+			weights[i] = t.Weight
+		}
+
+		start, end := period.Bounds(now, loc)
+		key := player.ID.String() + ":" + string(period) + ":" + start.Format(time.DateOnly)
+		for _, i := range game.SelectQuests(key, weights, period.Count()) {
+			active = append(active, ActiveQuest{
+				Template:    templates[i],
+				PeriodStart: start,
+				ResetsAt:    end,
+			})
+		}
+	}
+	return active, nil
+}
+
+// advanceQuests moves the player's active quests that follow the event and
+// returns the ones it completed
+func advanceQuests(ctx context.Context, q *Queries, playerID uuid.UUID, event game.Event) ([]ActiveQuest, error) {
+	player, err := q.GetPlayer(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	active, err := activeQuests(ctx, q, player, event.At)
+	if err != nil {
+		return nil, err
+	}
+
+	completed := []ActiveQuest{}
+	for _, quest := range active {
+		t := quest.Template
+		if t.Event != string(event.Kind) || (t.EggType.Valid && t.EggType.String != event.EggType) {
+			continue
+		}
+
+		amount, keepMax := game.GoalMetric(t.Metric).Advance(event)
+		progress, err := q.AdvanceQuest(ctx, AdvanceQuestParams{
+			PlayerID:     playerID,
+			TemplateCode: t.Code,
+			PeriodStart:  quest.Date(),
+			Amount:       amount,
+			Target:       t.Target,
+			KeepMax:      keepMax,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if progress.JustCompleted {
+			completed = append(completed, quest)
+		}
+	}
+	return completed, nil
+}
+
+// ClaimQuestTxParams contains the input of ClaimQuestTx
+type ClaimQuestTxParams struct {
+	PlayerID uuid.UUID
+	Code     string
+	Now      time.Time
+}
+
+// ClaimQuestTxResult is the result of ClaimQuestTx
+type ClaimQuestTxResult struct {
+	Quest    ActiveQuest  `json:"quest"`
+	Progress PlayerQuests `json:"progress"`
+	Player   Players      `json:"player"`
+	LevelUps []LevelUp    `json:"level_ups"`
+}
+
+// ClaimQuestTx marks a completed quest of the current period claimed and
+// pays its reward in a single transaction. It returns ErrRecordNotFound when
+// the quest is not one of the player's current quests, ErrNotCompleted when
+// the player has not completed it and ErrAlreadyClaimed when the reward was
+// paid before.
+func (s *Service) ClaimQuestTx(ctx context.Context, arg ClaimQuestTxParams) (ClaimQuestTxResult, error) {
+	var result ClaimQuestTxResult
+
+	err := s.ExecTx(ctx, func(q *Queries) error {
+		result = ClaimQuestTxResult{}
+
+		player, err := q.GetPlayer(ctx, arg.PlayerID)
+		if err != nil {
+			return err
+		}
+
+		active, err := activeQuests(ctx, q, player, arg.Now)
+		if err != nil {
+			return err
+		}
+		found := false
+		for _, quest := range active {
+			if quest.Template.Code == arg.Code {
+				result.Quest, found = quest, true
+				break
+			}
+		}
+		if !found {
+			return ErrRecordNotFound
+		}
+
+		claim := ClaimQuestParams{
+			PlayerID:     arg.PlayerID,
+			TemplateCode: arg.Code,
+			PeriodStart:  result.Quest.Date(),
+		}
+		result.Progress, err = q.ClaimQuest(ctx, claim)
+		if errors.Is(err, ErrRecordNotFound) {
+			progress, err := q.GetPlayerQuest(ctx, GetPlayerQuestParams(claim))
+			switch {
+			case errors.Is(err, ErrRecordNotFound), err == nil && !progress.CompletedAt.Valid:
+				return ErrNotCompleted
+			case err != nil:
+				return err
+			}
+			return ErrAlreadyClaimed
+		}
+		if err != nil {
+			return err
+		}
+
+		t := result.Quest.Template
+		date := result.Quest.PeriodStart.Format(time.DateOnly)
+		posted, err := s.postLedger(ctx, q, LedgerPosting{
+			PlayerID:       arg.PlayerID,
+			Coins:          t.RewardCoins,
+			Xp:             t.RewardXp,
+			Reason:         ReasonQuest,
+			ReferenceType:  "quest",
+			ReferenceID:    t.Code + ":" + date,
+			IdempotencyKey: "quest:" + arg.PlayerID.String() + ":" + t.Code + ":" + date,
+		})
+		if err != nil {
+			return err
+		}
+		result.Player = posted.Player
+		result.LevelUps = posted.LevelUps
+		return nil
+	})
+
+	return result, err
+}
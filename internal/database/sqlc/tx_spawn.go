@@ -0,0 +1,113 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/0xdbb/eggsplore/internal/game"
+	"github.com/google/uuid"
+)
+
+// ClaimSpawnTxParams contains the input of ClaimSpawnTx
+type ClaimSpawnTxParams struct {
+	FinderID    uuid.UUID
+	SpawnID     uuid.UUID
+	Lat         float64 // finder's position
+	Lon         float64
+	ClaimedAt   time.Time
+	PlantedAt   time.Time // lifecycle of the egg the spawn turns into
+	IncubatesAt time.Time
+	ReadyAt     time.Time
+	DecaysAt    time.Time
+	Coins       int64
+	Xp          int64
+	Event       game.Event
+
+	// Check runs on the locked spawn before anything is written. A non-nil
+	// error aborts the claim.
+	Check func(spawn GetSpawnForClaimRow) error
+}
+
+// ClaimSpawnTxResult is the result of ClaimSpawnTx
+type ClaimSpawnTxResult struct {
+	Egg       CreateEggFromSpawnRow `json:"egg"`
+	Inventory Inventory             `json:"inventory"`
+	Player    Players               `json:"player"`    // the finder after the reward
+	LevelUps  []LevelUp             `json:"level_ups"` // levels the finder reached
+	Goals     EventResult           `json:"goals"`     // goals the finder completed
+}
+
+// ClaimSpawnTx marks a spawned egg collected, turns it into an egg in the
+// finder's inventory, rewards the finder and records their event in a single
+// transaction. The spawn row stays locked from the check to the commit, so
+// of two players claiming the same spawn only the first one succeeds.
+func (s *Service) ClaimSpawnTx(ctx context.Context, arg ClaimSpawnTxParams) (ClaimSpawnTxResult, error) {
+	var result ClaimSpawnTxResult
+
+	err := s.ExecTx(ctx, func(q *Queries) error {
+		var err error
+		result = ClaimSpawnTxResult{}
+
+		spawn, err := q.GetSpawnForClaim(ctx, GetSpawnForClaimParams{
+			Lon: arg.Lon,
+			Lat: arg.Lat,
+			ID:  arg.SpawnID,
+		})
+		if err != nil {
+			return err
+		}
+		if arg.Check != nil {
+			if err = arg.Check(spawn); err != nil {
+				return err
+			}
+		}
+
+		err = q.ClaimSpawn(ctx, ClaimSpawnParams{
+			CollectedAt: arg.ClaimedAt,
+			CollectedBy: arg.FinderID,
+			ID:          arg.SpawnID,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Inventory, err = q.CreateEgg(ctx, CreateEggParams{
+			PlayerID: arg.FinderID,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Egg, err = q.CreateEggFromSpawn(ctx, CreateEggFromSpawnParams{
+			InventoryID: result.Inventory.ID,
+			PlantedAt:   arg.PlantedAt,
+			IncubatesAt: arg.IncubatesAt,
+			ReadyAt:     arg.ReadyAt,
+			DecaysAt:    arg.DecaysAt,
+			SpawnID:     arg.SpawnID,
+		})
+		if err != nil {
+			return err
+		}
+
+		posted, err := s.postLedger(ctx, q, LedgerPosting{
+			PlayerID:       arg.FinderID,
+			Coins:          arg.Coins,
+			Xp:             arg.Xp,
+			Reason:         ReasonCollect,
+			ReferenceType:  "spawn",
+			ReferenceID:    arg.SpawnID.String(),
+			IdempotencyKey: "claim-spawn:" + arg.SpawnID.String(),
+		})
+		if err != nil {
+			return err
+		}
+		result.Player = posted.Player
+		result.LevelUps = posted.LevelUps
+
+		result.Goals, err = recordEvent(ctx, q, arg.FinderID, arg.Event)
+		return err
+	})
+
+	return result, err
+}
@@ -0,0 +1,238 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/0xdbb/eggsplore/internal/game"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// streakOf returns the game view of a stored streak
+func streakOf(row LoginStreaks) game.Streak {
+	streak := game.Streak{
+		Current: row.Current,
+		Longest: row.Longest,
+		Freezes: row.Freezes,
+	}
+	if row.LastActiveOn.Valid {
+		streak.LastDay = row.LastActiveOn.Time
+	}
+	return streak
+}
+
+// dayDate returns a day as stored in DATE columns
+func dayDate(day time.Time) pgtype.Date {
+	return pgtype.Date{Time: day, Valid: true}
+}
+
+// loginStreakOrEmpty returns the player's streak, empty before their first
+// active day
+func loginStreakOrEmpty(ctx context.Context, q *Queries, playerID uuid.UUID) (LoginStreaks, error) {
+	row, err := q.GetLoginStreak(ctx, playerID)
+	if errors.Is(err, ErrRecordNotFound) {
+		return LoginStreaks{PlayerID: playerID}, nil
+	}
+	return row, err
+}
+
+// RecordActivityTx counts now as a day the player of the account was
+// active on, in the player's time zone, and returns their streak. Only the
+// first activity of a day writes anything.
+func (s *Service) RecordActivityTx(ctx context.Context, accountID uuid.UUID, now time.Time) (LoginStreaks, error) {
+	player, err := s.GetPlayerByAccount(ctx, accountID)
+	if err != nil {
+		return LoginStreaks{}, err
+	}
+	today := game.LocalDay(now, game.PlayerLocation(player.Settings))
+
+	row, err := loginStreakOrEmpty(ctx, s.Queries, player.ID)
+	if err != nil || (row.LastActiveOn.Valid && !today.After(row.LastActiveOn.Time)) {
+		return row, err
+	}
+
+	var result LoginStreaks
+	err = s.ExecTx(ctx, func(q *Queries) error {
+		var err error
+		result, err = recordActivity(ctx, q, player.ID, today)
+		return err
+	})
+
+	return result, err
+}
+
+// recordActivity moves the player's streak to today
+func recordActivity(ctx context.Context, q *Queries, playerID uuid.UUID, today time.Time) (LoginStreaks, error) {
+	row, err := loginStreakOrEmpty(ctx, q, playerID)
+	if err != nil || (row.LastActiveOn.Valid && !today.After(row.LastActiveOn.Time)) {
+		return row, err
+	}
+
+	streak, _ := streakOf(row).Visit(today)
+	return q.SetLoginStreak(ctx, SetLoginStreakParams{
+		PlayerID:     playerID,
+		Current:      streak.Current,
+		Longest:      streak.Longest,
+		LastActiveOn: dayDate(streak.LastDay),
+		Freezes:      streak.Freezes,
+	})
+}
+
+// StreakStatus is a player's streak and the next daily reward
+type StreakStatus struct {
+	Streak       LoginStreaks   `json:"streak"`
+	ClaimedToday bool           `json:"claimed_today"`
+	NextDay      int32          `json:"next_day"` // calendar day of the next reward
+	NextReward   DailyRewards   `json:"next_reward"`
+	NextAt       time.Time      `json:"next_at"` // when the next reward can be claimed
+	Calendar     []DailyRewards `json:"calendar"`
+}
+
+// LoginStreak returns the player's streak at now and the next daily reward
+// they can claim. A reward claimed today makes tomorrow's, on a streak that
+// goes on, the next one.
+func (s *Service) LoginStreak(ctx context.Context, player Players, now time.Time) (StreakStatus, error) {
+	var status StreakStatus
+	var err error
+
+	loc := game.PlayerLocation(player.Settings)
+	today := game.LocalDay(now, loc)
+
+	status.Streak, err = loginStreakOrEmpty(ctx, s.Queries, player.ID)
+	if err != nil {
+		return status, err
+	}
+	status.Calendar, err = s.ListDailyRewards(ctx)
+	if err != nil {
+		return status, err
+	}
+
+	_, err = s.GetDailyRewardClaim(ctx, GetDailyRewardClaimParams{
+		PlayerID:  player.ID,
+		ClaimedOn: dayDate(today),
+	})
+	switch {
+	case err == nil:
+		status.ClaimedToday = true
+	case !errors.Is(err, ErrRecordNotFound):
+		return status, err
+	}
+
+	// The streak only counts today once the player was active today
+	streak, _ := streakOf(status.Streak).Visit(today)
+	status.NextDay = game.RewardDay(streak.Current)
+	status.NextAt = now
+	if status.ClaimedToday {
+		status.NextDay = game.RewardDay(streak.Current + 1)
+		_, status.NextAt = game.QuestDaily.Bounds(now, loc)
+	}
+	status.NextReward = dailyReward(status.Calendar, status.NextDay)
+	return status, nil
+}
+
+// dailyReward returns the reward of a calendar day, nothing for a day
+// without one
+func dailyReward(calendar []DailyRewards, day int32) DailyRewards {
+	for _, reward := range calendar {
+		if reward.Day == day {
+			return reward
+		}
+	}
+	return DailyRewards{Day: day}
+}
+
+// ClaimDailyRewardTxParams contains the input of ClaimDailyRewardTx
+type ClaimDailyRewardTxParams struct {
+	PlayerID uuid.UUID
+	Now      time.Time
+}
+
+// ClaimDailyRewardTxResult is the result of ClaimDailyRewardTx
+type ClaimDailyRewardTxResult struct {
+	Reward       DailyRewards `json:"reward"`
+	Streak       LoginStreaks `json:"streak"`
+	Player       Players      `json:"player"`
+	LevelUps     []LevelUp    `json:"level_ups"`
+	InventoryIDs []uuid.UUID  `json:"inventory_ids"` // items granted
+}
+
+// ClaimDailyRewardTx pays the daily reward of the player's streak day in a
+// single transaction. It returns ErrAlreadyClaimed when the player claimed a
+// reward today, in their time zone.
+func (s *Service) ClaimDailyRewardTx(ctx context.Context, arg ClaimDailyRewardTxParams) (ClaimDailyRewardTxResult, error) {
+	var result ClaimDailyRewardTxResult
+
+	err := s.ExecTx(ctx, func(q *Queries) error {
+		result = ClaimDailyRewardTxResult{InventoryIDs: []uuid.UUID{}}
+
+		player, err := q.GetPlayer(ctx, arg.PlayerID)
+		if err != nil {
+			return err
+		}
+		today := game.LocalDay(arg.Now, game.PlayerLocation(player.Settings))
+
+		result.Streak, err = recordActivity(ctx, q, player.ID, today)
+		if err != nil {
+			return err
+		}
+		day := game.RewardDay(result.Streak.Current)
+
+		_, err = q.CreateDailyRewardClaim(ctx, CreateDailyRewardClaimParams{
+			PlayerID:  player.ID,
+			ClaimedOn: dayDate(today),
+			Day:       day,
+			Streak:    result.Streak.Current,
+		})
+		if errors.Is(err, ErrRecordNotFound) {
+			return ErrAlreadyClaimed
+		}
+		if err != nil {
+			return err
+		}
+
+		calendar, err := q.ListDailyRewards(ctx)
+		if err != nil {
+			return err
+		}
+		result.Reward = dailyReward(calendar, day)
+
+		date := today.Format(time.DateOnly)
+		posted, err := s.postLedger(ctx, q, LedgerPosting{
+			PlayerID:       player.ID,
+			Coins:          result.Reward.Coins,
+			Xp:             result.Reward.Xp,
+			Reason:         ReasonDailyReward,
+			ReferenceType:  "daily_reward",
+			ReferenceID:    date + ":" + strconv.Itoa(int(day)),
+			IdempotencyKey: "daily-reward:" + player.ID.String() + ":" + date,
+		})
+		if err != nil {
+			return err
+		}
+		result.Player = posted.Player
+		result.LevelUps = posted.LevelUps
+
+		if result.Reward.ShopItemCode.Valid {
+			item, err := q.GetShopItemByCode(ctx, result.Reward.ShopItemCode.String)
+			if err != nil {
+				return err
+			}
+			ids, err := grantShopItem(ctx, q, player.ID, item, result.Reward.Packs)
+			switch {
+			case err == nil:
+				result.InventoryIDs = ids
+			case !errors.Is(err, ErrAlreadyUnlocked):
+				return err
+			}
+		}
+
+		// A granted streak freeze changed the streak
+		result.Streak, err = loginStreakOrEmpty(ctx, q, player.ID)
+		return err
+	})
+
+	return result, err
+}
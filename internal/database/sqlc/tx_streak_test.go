@@ -0,0 +1,42 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestClaimDailyRewardTxOncePerDay(t *testing.T) {
+	s := testService(t)
+	ctx := context.Background()
+	player := testPlayer(t, s, 0)
+	morning := time.Date(2025, 4, 20, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		now        time.Time
+		wantErr    error
+		wantDay    int32
+		wantStreak int32
+	}{
+		{"first claim", morning, nil, 1, 1},
+		{"same day", morning.Add(12 * time.Hour), ErrAlreadyClaimed, 0, 0},
+		{"next day", morning.AddDate(0, 0, 1), nil, 2, 2},
+		{"next day again", morning.AddDate(0, 0, 1).Add(time.Hour), ErrAlreadyClaimed, 0, 0},
+	}
+
+	// Steps build on each other, so they are not run as subtests
+	for _, tt := range tests {
+		result, err := s.ClaimDailyRewardTx(ctx, ClaimDailyRewardTxParams{PlayerID: player.ID, Now: tt.now})
+		if !errors.Is(err, tt.wantErr) {
+			t.Fatalf("%s: ClaimDailyRewardTx() = %v, want %v", tt.name, err, tt.wantErr)
+		}
+		if err != nil {
+			continue
+		}
+		if result.Reward.Day != tt.wantDay || result.Streak.Current != tt.wantStreak {
+			t.Errorf("%s: claimed day %d on a streak of %d, want day %d on %d", tt.name, result.Reward.Day, result.Streak.Current, tt.wantDay, tt.wantStreak)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// testService returns a service on the migrated database at
+// TEST_DATABASE_URL, the test is skipped when it is not set
+func testService(t *testing.T) *Service {
+	t.Helper()
+	url := os.Getenv("TEST_DATABASE_URL")
+	if url == "" {
+		t.Skip("TEST_DATABASE_URL not set")
+	}
+
+	s := NewService(url)
+	t.Cleanup(s.connPool.Close)
+	return s
+}
+
+// testPlayer creates a player with coins and nothing else
+func testPlayer(t *testing.T, s *Service, coins int64) Players {
+	t.Helper()
+	result, err := s.CreateAccountTx(context.Background(), CreateAccountTxParams{
+		CreateAccountParams: CreateAccountParams{
+			Email:    uuid.NewString() + "@example.com",
+			Password: "not-a-hash",
+			Username: pgtype.Text{String: "test_" + uuid.NewString()[:8], Valid: true},
+		},
+		StarterKit: StarterKit{Coins: coins},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return result.Player
+}
+
+func TestExecTxRetry(t *testing.T) {
+	s := testService(t)
+	ctx := context.Background()
+	failure := func(code string) error { return &pgconn.PgError{Code: code} }
+	other := errors.New("not retried")
+
+	tests := []struct {
+		name         string
+		errs         []error // returned by the attempts in turn, nil after
+		wantAttempts int
+		wantErr      error
+	}{
+		{"commits first time", nil, 1, nil},
+		{"serialization failure", []error{failure(SerializationFailure)}, 2, nil},
+		{"deadlock", []error{failure(DeadlockDetected), failure(DeadlockDetected)}, 3, nil},
+		{"other error", []error{other}, 1, other},
+		{
+			"gives up",
+			[]error{
+				failure(SerializationFailure), failure(SerializationFailure), failure(SerializationFailure),
+				failure(SerializationFailure), failure(SerializationFailure), failure(SerializationFailure),
+			},
+			maxTxAttempts,
+			failure(SerializationFailure),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			err := s.ExecTx(ctx, func(q *Queries) error {
+				attempts++
+				if attempts <= len(tt.errs) {
+					return tt.errs[attempts-1]
+				}
+				return nil
+			})
+
+			if attempts != tt.wantAttempts {
+				t.Errorf("ran %d attempts, want %d", attempts, tt.wantAttempts)
+			}
+			switch {
+			case tt.wantErr == nil && err != nil:
+				t.Errorf("ExecTx() = %v, want no error", err)
+			case tt.wantErr != nil && ErrorCode(err) != ErrorCode(tt.wantErr) && !errors.Is(err, tt.wantErr):
+				t.Errorf("ExecTx() = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestExecTxConcurrentUpdates(t *testing.T) {
+	s := testService(t)
+	ctx := context.Background()
+	player := testPlayer(t, s, 0)
+
+	// Every transaction reads and writes the same player, so serializable
+	// isolation aborts all but one of those running at once
+	const n = 4
+	errs := make(chan error, n)
+	for range n {
+		go func() {
+			errs <- s.ExecTx(ctx, func(q *Queries) error {
+				p, err := q.GetPlayer(ctx, player.ID)
+				if err != nil {
+					return err
+				}
+				_, err = q.UpdatePlayerStats(ctx, UpdatePlayerStatsParams{ID: p.ID, Coins: 1})
+				return err
+			})
+		}()
+	}
+	for range n {
+		if err := <-errs; err != nil {
+			t.Errorf("ExecTx() = %v, want retries to succeed", err)
+		}
+	}
+
+	got, err := s.GetPlayer(ctx, player.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Coins != n {
+		t.Errorf("coins = %d, want %d", got.Coins, n)
+	}
+}
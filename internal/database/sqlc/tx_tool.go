@@ -0,0 +1,104 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/0xdbb/eggsplore/internal/game"
+	"github.com/google/uuid"
+)
+
+// ToolUse is an equipped tool worn down by a game action
+type ToolUse struct {
+	InventoryID uuid.UUID
+	Wear        int32
+}
+
+// WornTool is a tool after it was used. A tool that reaches zero
+// durability breaks and is removed from the inventory.
+type WornTool struct {
+	Tool   Tools `json:"tool"`
+	Broken bool  `json:"broken"`
+}
+
+// EquipToolTx equips a player's tool, unequipping whatever the player had
+// in the same slot. It returns ErrRecordNotFound when the tool does not
+// exist or belongs to another player.
+func (s *Service) EquipToolTx(ctx context.Context, playerID, toolID uuid.UUID) (Tools, error) {
+	var tool Tools
+
+	err := s.ExecTx(ctx, func(q *Queries) error {
+		current, err := q.GetTool(ctx, toolID)
+		if err != nil {
+			return err
+		}
+		if current.PlayerID != playerID {
+			return ErrRecordNotFound
+		}
+
+		err = q.UnequipSlot(ctx, UnequipSlotParams{
+			PlayerID: playerID,
+			Slot:     current.Slot,
+		})
+		if err != nil {
+			return err
+		}
+
+		tool, err = q.SetToolEquipped(ctx, SetToolEquippedParams{
+			Equipped:    true,
+			InventoryID: toolID,
+		})
+		return err
+	})
+
+	return tool, err
+}
+
+// WearToolTx consumes durability of a tool used outside of any other
+// transaction, breaking it at zero.
+func (s *Service) WearToolTx(ctx context.Context, use ToolUse) (WornTool, error) {
+	var worn WornTool
+
+	err := s.ExecTx(ctx, func(q *Queries) error {
+		var err error
+		worn, err = useTool(ctx, q, use)
+		return err
+	})
+
+	return worn, err
+}
+
+// useTool wears down an equipped tool, breaking it at zero durability
+func useTool(ctx context.Context, q *Queries, use ToolUse) (WornTool, error) {
+	tool, err := q.WearTool(ctx, WearToolParams{
+		Wear:        use.Wear,
+		InventoryID: use.InventoryID,
+	})
+	if err != nil {
+		return WornTool{}, err
+	}
+
+	if tool.Durability > 0 {
+		return WornTool{Tool: tool}, nil
+	}
+
+	tool.Equipped = false
+	if err := q.DeleteInventoryItem(ctx, tool.InventoryID); err != nil {
+		return WornTool{}, err
+	}
+	return WornTool{Tool: tool, Broken: true}, nil
+}
+
+// createToolDetails creates the tools row of a new tool inventory item
+func createToolDetails(ctx context.Context, q *Queries, inventoryID uuid.UUID, kindName string) (Tools, error) {
+	kind, ok := game.ParseToolKind(kindName)
+	if !ok {
+		return Tools{}, fmt.Errorf("%w: %q", ErrUnknownToolKind, kindName)
+	}
+
+	return q.AddToolDetails(ctx, AddToolDetailsParams{
+		InventoryID: inventoryID,
+		Kind:        string(kind),
+		Slot:        string(kind.Spec().Slot),
+	})
+}
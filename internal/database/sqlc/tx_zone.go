@@ -0,0 +1,25 @@
+package database
+
+import (
+	"context"
+)
+
+// ImportExclusionZonesTx creates all given zones in a single transaction, so
+// an import with one bad geometry creates nothing.
+func (s *Service) ImportExclusionZonesTx(ctx context.Context, zones []CreateExclusionZoneFromGeoJSONParams) ([]CreateExclusionZoneFromGeoJSONRow, error) {
+	var created []CreateExclusionZoneFromGeoJSONRow
+
+	err := s.ExecTx(ctx, func(q *Queries) error {
+		created = make([]CreateExclusionZoneFromGeoJSONRow, 0, len(zones))
+		for _, zone := range zones {
+			row, err := q.CreateExclusionZoneFromGeoJSON(ctx, zone)
+			if err != nil {
+				return err
+			}
+			created = append(created, row)
+		}
+		return nil
+	})
+
+	return created, err
+}
@@ -0,0 +1,164 @@
+package game
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Signals of a faked location, recorded on incidents
+const (
+	SignalImplausibleSpeed = "IMPLAUSIBLE_SPEED"
+	SignalRepeatedCoords   = "REPEATED_COORDINATES"
+	SignalPerfectAccuracy  = "PERFECT_ACCURACY"
+)
+
+// What happens to an action taken at an evaluated location
+const (
+	ActionAllow  = "ALLOW"
+	ActionDelay  = "DELAY"
+	ActionReject = "REJECT"
+)
+
+const (
+	repeatsAllowed       = 1  // devices sometimes resend their last fix unchanged
+	repeatScore          = 10 // per identical fix beyond repeatsAllowed
+	maxRepeatScore       = 40
+	perfectAccuracyScore = 30
+	constAccuracyScore   = 20
+	constAccuracyFixes   = 3 // trail fixes that must share the accuracy to count
+)
+
+// Fix is a position reported by a player's device.
+type Fix struct {
+	Lat      float64
+	Lon      float64
+	Accuracy float64 // meters, negative when the client did not report it
+	At       time.Time
+}
+
+// TrailFix is an earlier fix of the same player.
+type TrailFix struct {
+	Fix
+	Distance float64 // meters to the fix being evaluated
+}
+
+// AntiCheatRules decide how suspicious a reported location is.
+type AntiCheatRules struct {
+	MaxSpeed    float64       // meters per second a player can plausibly move
+	MinAccuracy float64       // accuracies below this many meters are too good for a phone
+	DelayScore  int           // scores from here on delay the action
+	RejectScore int           // scores from here on reject it
+	Hold        time.Duration // shortest time a delayed player waits
+}
+
+// Signal is one reason a location looks faked.
+type Signal struct {
+	Kind   string `json:"kind"`
+	Score  int    `json:"score"`
+	Detail string `json:"detail"`
+}
+
+// Verdict is the outcome of evaluating a location.
+type Verdict struct {
+	Score   int
+	Signals []Signal
+	Action  string
+	Hold    time.Duration // how long actions wait, for delayed ones
+}
+
+// Flagged reports whether any signal was raised.
+func (v Verdict) Flagged() bool {
+	return len(v.Signals) > 0
+}
+
+// Evaluate scores fix against the player's earlier fixes, newest first, and
+// decides whether actions taken there are allowed, delayed or rejected.
+// A delayed player waits at least Hold, or as long as it would take to
+// cover the distance from their trail at MaxSpeed.
+func (r AntiCheatRules) Evaluate(fix Fix, trail []TrailFix) Verdict {
+	v := Verdict{Signals: []Signal{}, Action: ActionAllow}
+	var travel time.Duration
+
+	if r.MaxSpeed > 0 {
+		var fastest float64
+		for _, t := range trail {
+			// Movement within the reported accuracy may just be jitter
+			distance := t.Distance - max(fix.Accuracy, 0) - max(t.Accuracy, 0)
+			if distance <= 0 {
+				continue
+			}
+			elapsed := max(fix.At.Sub(t.At), time.Second)
+			speed := distance / elapsed.Seconds()
+			fastest = max(fastest, speed)
+
+			need := time.Duration(distance / r.MaxSpeed * float64(time.Second))
+			travel = max(travel, need-elapsed)
+		}
+		if fastest > r.MaxSpeed {
+			v.add(Signal{
+				Kind:   SignalImplausibleSpeed,
+				Score:  min(100, int(50*fastest/r.MaxSpeed)),
+				Detail: fmt.Sprintf("moved at %.0f m/s, at most %.0f m/s is plausible", fastest, r.MaxSpeed),
+			})
+		}
+	}
+
+	repeats := 0
+	for _, t := range trail {
+		if t.Lat == fix.Lat && t.Lon == fix.Lon {
+			repeats++
+		}
+	}
+	if repeats > repeatsAllowed {
+		v.add(Signal{
+			Kind:   SignalRepeatedCoords,
+			Score:  min(maxRepeatScore, repeatScore*(repeats-repeatsAllowed)),
+			Detail: fmt.Sprintf("same coordinates as %d earlier fixes", repeats),
+		})
+	}
+
+	if fix.Accuracy >= 0 {
+		if fix.Accuracy < r.MinAccuracy {
+			v.add(Signal{
+				Kind:   SignalPerfectAccuracy,
+				Score:  perfectAccuracyScore,
+				Detail: fmt.Sprintf("accuracy of %.1fm, below %.1fm", fix.Accuracy, r.MinAccuracy),
+			})
+		} else if constantAccuracy(fix, trail) {
+			v.add(Signal{
+				Kind:   SignalPerfectAccuracy,
+				Score:  constAccuracyScore,
+				Detail: fmt.Sprintf("accuracy of exactly %.1fm on every recent fix", fix.Accuracy),
+			})
+		}
+	}
+
+	switch {
+	case r.RejectScore > 0 && v.Score >= r.RejectScore:
+		v.Action = ActionReject
+	case r.DelayScore > 0 && v.Score >= r.DelayScore:
+		v.Action = ActionDelay
+		v.Hold = max(r.Hold, travel).Round(time.Second)
+	}
+	return v
+}
+
+func (v *Verdict) add(s Signal) {
+	v.Signals = append(v.Signals, s)
+	v.Score += s.Score
+}
+
+// constantAccuracy reports whether the fix and enough of the trail before it
+// all claim the very same accuracy, which real receivers never hold.
+func constantAccuracy(fix Fix, trail []TrailFix) bool {
+	if len(trail) < constAccuracyFixes {
+		return false
+	}
+	for _, t := range trail[:constAccuracyFixes] {
+		if math.Abs(t.Accuracy-fix.Accuracy) > 1e-9 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,169 @@
+package game
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAntiCheatEvaluate(t *testing.T) {
+	now := time.Date(2025, 4, 20, 12, 0, 0, 0, time.UTC)
+	rules := AntiCheatRules{
+		MaxSpeed:    50,
+		MinAccuracy: 2,
+		DelayScore:  40,
+		RejectScore: 80,
+		Hold:        5 * time.Minute,
+	}
+	fix := Fix{Lat: 5.6, Lon: -0.2, Accuracy: 10, At: now}
+	trailFix := func(ago time.Duration, distance, accuracy float64) TrailFix {
+		return TrailFix{
+			Fix:      Fix{Lat: 5.7, Lon: -0.3, Accuracy: accuracy, At: now.Add(-ago)},
+			Distance: distance,
+		}
+	}
+	repeat := TrailFix{Fix: Fix{Lat: fix.Lat, Lon: fix.Lon, Accuracy: 12, At: now.Add(-time.Minute)}}
+
+	tests := []struct {
+		name        string
+		fix         Fix
+		trail       []TrailFix
+		wantSignals []string
+		wantScore   int
+		wantAction  string
+		wantHold    time.Duration
+	}{
+		{
+			name:       "no trail",
+			fix:        fix,
+			wantAction: ActionAllow,
+		},
+		{
+			name:       "walking",
+			fix:        fix,
+			trail:      []TrailFix{trailFix(time.Minute, 120, 10)},
+			wantAction: ActionAllow,
+		},
+		{
+			name:       "jitter within accuracy",
+			fix:        fix,
+			trail:      []TrailFix{trailFix(time.Second, 15, 10)},
+			wantAction: ActionAllow,
+		},
+		{
+			name:        "twice the max speed",
+			fix:         fix,
+			trail:       []TrailFix{trailFix(10*time.Second, 1020, 0)},
+			wantSignals: []string{SignalImplausibleSpeed},
+			wantScore:   100,
+			wantAction:  ActionReject,
+		},
+		{
+			name:        "a bit too fast is delayed until the trip is plausible",
+			fix:         Fix{Lat: 5.6, Lon: -0.2, Accuracy: -1, At: now},
+			trail:       []TrailFix{trailFix(1000*time.Second, 75000, -1)},
+			wantSignals: []string{SignalImplausibleSpeed},
+			wantScore:   75,
+			wantAction:  ActionDelay,
+			wantHold:    500 * time.Second,
+		},
+		{
+			name:        "hold is at least the configured one",
+			fix:         Fix{Lat: 5.6, Lon: -0.2, Accuracy: -1, At: now},
+			trail:       []TrailFix{trailFix(100*time.Second, 5500, -1)},
+			wantSignals: []string{SignalImplausibleSpeed},
+			wantScore:   55,
+			wantAction:  ActionDelay,
+			wantHold:    5 * time.Minute,
+		},
+		{
+			name:       "one repeat is allowed",
+			fix:        fix,
+			trail:      []TrailFix{repeat},
+			wantAction: ActionAllow,
+		},
+		{
+			name:        "repeated coordinates",
+			fix:         fix,
+			trail:       []TrailFix{repeat, repeat, repeat},
+			wantSignals: []string{SignalRepeatedCoords},
+			wantScore:   20,
+			wantAction:  ActionAllow,
+		},
+		{
+			name:        "repeated coordinates are capped",
+			fix:         fix,
+			trail:       []TrailFix{repeat, repeat, repeat, repeat, repeat, repeat, repeat, repeat},
+			wantSignals: []string{SignalRepeatedCoords},
+			wantScore:   40,
+			wantAction:  ActionDelay,
+			wantHold:    5 * time.Minute,
+		},
+		{
+			name:        "perfect accuracy",
+			fix:         Fix{Lat: 5.6, Lon: -0.2, Accuracy: 0, At: now},
+			wantSignals: []string{SignalPerfectAccuracy},
+			wantScore:   30,
+			wantAction:  ActionAllow,
+		},
+		{
+			name:       "unknown accuracy is not perfect",
+			fix:        Fix{Lat: 5.6, Lon: -0.2, Accuracy: -1, At: now},
+			wantAction: ActionAllow,
+		},
+		{
+			name: "constant accuracy",
+			fix:  fix,
+			trail: []TrailFix{
+				trailFix(time.Minute, 10, 10),
+				trailFix(2*time.Minute, 20, 10),
+				trailFix(3*time.Minute, 30, 10),
+			},
+			wantSignals: []string{SignalPerfectAccuracy},
+			wantScore:   20,
+			wantAction:  ActionAllow,
+		},
+		{
+			name: "signals add up",
+			fix:  Fix{Lat: 5.6, Lon: -0.2, Accuracy: 1, At: now},
+			trail: []TrailFix{
+				{Fix: Fix{Lat: 5.6, Lon: -0.2, Accuracy: 1, At: now.Add(-time.Minute)}},
+				{Fix: Fix{Lat: 5.6, Lon: -0.2, Accuracy: 1, At: now.Add(-2 * time.Minute)}},
+			},
+			wantSignals: []string{SignalRepeatedCoords, SignalPerfectAccuracy},
+			wantScore:   40,
+			wantAction:  ActionDelay,
+			wantHold:    5 * time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := rules.Evaluate(tt.fix, tt.trail)
+
+			var kinds []string
+			for _, s := range v.Signals {
+				kinds = append(kinds, s.Kind)
+			}
+			if len(kinds) != len(tt.wantSignals) {
+				t.Fatalf("signals = %v, want %v", kinds, tt.wantSignals)
+			}
+			for i := range kinds {
+				if kinds[i] != tt.wantSignals[i] {
+					t.Fatalf("signals = %v, want %v", kinds, tt.wantSignals)
+				}
+			}
+			if v.Flagged() != (len(tt.wantSignals) > 0) {
+				t.Errorf("Flagged() = %v with signals %v", v.Flagged(), kinds)
+			}
+			if v.Score != tt.wantScore {
+				t.Errorf("score = %d, want %d", v.Score, tt.wantScore)
+			}
+			if v.Action != tt.wantAction {
+				t.Errorf("action = %s, want %s", v.Action, tt.wantAction)
+			}
+			if v.Hold != tt.wantHold {
+				t.Errorf("hold = %s, want %s", v.Hold, tt.wantHold)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package game
+
+import (
+	"math"
+	"time"
+)
+
+// BoostKind is what a boost does while active.
+type BoostKind string
+
+const (
+	BoostXP         BoostKind = "XP_MULTIPLIER"    // multiplies hatch XP
+	BoostIncubation BoostKind = "INCUBATION_SPEED" // divides the incubation time of dropped eggs
+	BoostDiscovery  BoostKind = "DISCOVERY_RADIUS" // multiplies the nearby search radius cap
+)
+
+// Boosts holds the combined multiplier of each kind of a player's active boosts.
+type Boosts map[BoostKind]float64
+
+// Add stacks an active boost. Boosts of the same kind multiply.
+func (b Boosts) Add(kind BoostKind, multiplier float64) {
+	if multiplier <= 0 {
+		return
+	}
+	b[kind] = b.Multiplier(kind) * multiplier
+}
+
+// Multiplier returns the combined multiplier of a kind, 1 when none is active.
+func (b Boosts) Multiplier(kind BoostKind) float64 {
+	if m, ok := b[kind]; ok {
+		return m
+	}
+	return 1
+}
+
+// Apply returns the lifecycle with the incubation time shortened by an
+// active incubation boost.
+func (lc EggLifecycle) Apply(b Boosts) EggLifecycle {
+	if speed := b.Multiplier(BoostIncubation); speed > 1 {
+		lc.Incubation = time.Duration(float64(lc.Incubation) / speed)
+	}
+	return lc
+}
+
+// Apply returns the reward with XP multiplied by an active XP boost.
+func (r EggReward) Apply(b Boosts) EggReward {
+	r.XP = int64(math.Round(float64(r.XP) * b.Multiplier(BoostXP)))
+	return r
+}
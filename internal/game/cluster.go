@@ -0,0 +1,34 @@
+package game
+
+// Map zoom levels eggs can be asked for at
+const (
+	MinMapZoom = 0
+	MaxMapZoom = 22
+)
+
+// PointsZoom is the zoom level from which eggs come back one by one rather
+// than in clusters. A tile there spans a few hundred meters.
+const PointsZoom = 17
+
+// ClusterCells is how many grid cells a tile is split into across and down
+// when clustering, so each cluster stands for an area of roughly 32x32
+// pixels on a 256 pixel tile.
+const ClusterCells = 8
+
+// MaxTilePoints caps the eggs listed one by one in a single tile
+const MaxTilePoints = 200
+
+// ShowPoints reports whether eggs are listed one by one at zoom
+func ShowPoints(zoom int) bool {
+	return zoom >= PointsZoom
+}
+
+// BlurCellDegrees is the grid other players' eggs snap to wherever they are
+// listed, so an egg only gives away the cell it lies in, roughly 550m
+// across. Only the owner sees where exactly it is, everyone else has to
+// narrow it down with hints.
+const BlurCellDegrees = 0.005
+
+// BlurMarginMeters is the farthest an egg moves when it snaps to its blur
+// cell, half the diagonal of a cell at the equator.
+const BlurMarginMeters = 400
@@ -0,0 +1,97 @@
+package game
+
+import (
+	"fmt"
+	"time"
+)
+
+// Machine-readable codes of the collect rules, returned to clients as error_code.
+const (
+	CollectOwnEgg           = "COLLECT_OWN_EGG"
+	CollectShielded         = "COLLECT_EGG_SHIELDED"
+	CollectAlreadyCollected = "COLLECT_ALREADY_COLLECTED"
+	CollectUnavailable      = "COLLECT_EGG_UNAVAILABLE"
+	CollectTooFar           = "COLLECT_TOO_FAR"
+)
+
+// CollectError is a collect rejected by one of the collect rules.
+type CollectError struct {
+	Code    string
+	Message string
+}
+
+func (e *CollectError) Error() string {
+	return e.Message
+}
+
+// CollectAttempt is what the rules need to know about a player picking up
+// an egg another player dropped.
+type CollectAttempt struct {
+	Own       bool // the egg is the finder's own
+	Collected bool // someone picked the egg up already
+	Shielded  bool
+	State     EggState
+	DecaysAt  time.Time
+	Distance  float64 // meters between the finder and the egg
+	Reach     float64 // meters the finder can collect from
+	Now       time.Time
+}
+
+// Check returns a *CollectError for the first rule the attempt breaks.
+func (a CollectAttempt) Check() error {
+	if a.Own {
+		return &CollectError{CollectOwnEgg, "You cannot collect your own egg"}
+	}
+	if a.Collected {
+		return &CollectError{CollectAlreadyCollected, "Someone collected this egg already"}
+	}
+	if a.State.IsTerminal() || !a.Now.Before(a.DecaysAt) {
+		return &CollectError{CollectUnavailable, "Egg is no longer in the world"}
+	}
+	if a.Shielded {
+		return &CollectError{CollectShielded, "Egg is protected by a shield"}
+	}
+	// The distance itself is left out, repeated attempts would locate the egg
+	if a.Distance > a.Reach {
+		return &CollectError{CollectTooFar, fmt.Sprintf("Must be within %.0fm", a.Reach)}
+	}
+	return nil
+}
+
+// SpawnClaim is what the rules need to know about a player picking up an egg
+// the world spawner placed.
+type SpawnClaim struct {
+	Collected bool // someone claimed the spawn already
+	ExpiresAt time.Time
+	Distance  float64 // meters between the finder and the spawn
+	Reach     float64 // meters the finder can collect from
+	Now       time.Time
+}
+
+// Check returns a *CollectError for the first rule the claim breaks.
+func (c SpawnClaim) Check() error {
+	if c.Collected {
+		return &CollectError{CollectAlreadyCollected, "Someone collected this egg already"}
+	}
+	if !c.Now.Before(c.ExpiresAt) {
+		return &CollectError{CollectUnavailable, "Egg is no longer in the world"}
+	}
+	if c.Distance > c.Reach {
+		return &CollectError{CollectTooFar, fmt.Sprintf("Must be within %.0fm", c.Reach)}
+	}
+	return nil
+}
+
+// FinderReward is what a player earns for collecting an egg, half of the
+// hatch reward of its egg type.
+func FinderReward(hatch EggReward) EggReward {
+	return EggReward{XP: hatch.XP / 2, Coins: hatch.Coins / 2}
+}
+
+// CollectRewards splits the hatch reward of an egg type between the player
+// who found an egg and the one who dropped it. The finder gets half, the
+// dropper a quarter.
+func CollectRewards(hatch EggReward) (finder, dropper EggReward) {
+	dropper = EggReward{XP: hatch.XP / 4, Coins: hatch.Coins / 4}
+	return FinderReward(hatch), dropper
+}
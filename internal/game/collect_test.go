@@ -0,0 +1,105 @@
+package game
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCollectAttemptCheck(t *testing.T) {
+	now := time.Date(2025, 4, 20, 12, 0, 0, 0, time.UTC)
+	ok := CollectAttempt{
+		State:    EggIncubating,
+		DecaysAt: now.Add(time.Hour),
+		Distance: 20,
+		Reach:    50,
+		Now:      now,
+	}
+
+	tests := []struct {
+		name   string
+		modify func(a *CollectAttempt)
+		want   string // error code, empty when the collect is allowed
+	}{
+		{"allowed", func(a *CollectAttempt) {}, ""},
+		{"ready egg", func(a *CollectAttempt) { a.State = EggReady }, ""},
+		{"own egg", func(a *CollectAttempt) { a.Own = true }, CollectOwnEgg},
+		{"collected already", func(a *CollectAttempt) { a.Collected = true }, CollectAlreadyCollected},
+		{"hatched", func(a *CollectAttempt) { a.State = EggHatched }, CollectUnavailable},
+		{"decayed", func(a *CollectAttempt) { a.State = EggDecayed }, CollectUnavailable},
+		{"decay time passed", func(a *CollectAttempt) { a.DecaysAt = now }, CollectUnavailable},
+		{"shielded", func(a *CollectAttempt) { a.Shielded = true }, CollectShielded},
+		{"exactly at reach", func(a *CollectAttempt) { a.Distance = 50 }, ""},
+		{"too far", func(a *CollectAttempt) { a.Distance = 50.5 }, CollectTooFar},
+		{"own checked first", func(a *CollectAttempt) { a.Own = true; a.Collected = true; a.Distance = 500 }, CollectOwnEgg},
+		{"shield checked before distance", func(a *CollectAttempt) { a.Shielded = true; a.Distance = 500 }, CollectShielded},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempt := ok
+			tt.modify(&attempt)
+			checkCollectError(t, attempt.Check(), tt.want)
+		})
+	}
+}
+
+func TestSpawnClaimCheck(t *testing.T) {
+	now := time.Date(2025, 4, 20, 12, 0, 0, 0, time.UTC)
+	ok := SpawnClaim{ExpiresAt: now.Add(time.Minute), Distance: 20, Reach: 50, Now: now}
+
+	tests := []struct {
+		name   string
+		modify func(c *SpawnClaim)
+		want   string
+	}{
+		{"allowed", func(c *SpawnClaim) {}, ""},
+		{"claimed already", func(c *SpawnClaim) { c.Collected = true }, CollectAlreadyCollected},
+		{"expired", func(c *SpawnClaim) { c.ExpiresAt = now }, CollectUnavailable},
+		{"exactly at reach", func(c *SpawnClaim) { c.Distance = 50 }, ""},
+		{"too far", func(c *SpawnClaim) { c.Distance = 51 }, CollectTooFar},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claim := ok
+			tt.modify(&claim)
+			checkCollectError(t, claim.Check(), tt.want)
+		})
+	}
+}
+
+func TestCollectTooFarHidesDistance(t *testing.T) {
+	now := time.Date(2025, 4, 20, 12, 0, 0, 0, time.UTC)
+	errs := []error{
+		CollectAttempt{State: EggReady, DecaysAt: now.Add(time.Hour), Distance: 1234, Reach: 50, Now: now}.Check(),
+		SpawnClaim{ExpiresAt: now.Add(time.Hour), Distance: 1234, Reach: 50, Now: now}.Check(),
+	}
+	for _, err := range errs {
+		if err == nil {
+			t.Fatal("Check() = nil, want too far")
+		}
+		if strings.Contains(err.Error(), "1234") {
+			t.Errorf("Check() = %q reveals the distance", err)
+		}
+	}
+}
+
+func checkCollectError(t *testing.T, err error, want string) {
+	t.Helper()
+	if want == "" {
+		if err != nil {
+			t.Fatalf("Check() = %v, want nil", err)
+		}
+		return
+	}
+
+	var collectErr *CollectError
+	if !errors.As(err, &collectErr) {
+		t.Fatalf("Check() = %v, want a *CollectError", err)
+	}
+	if collectErr.Code != want {
+		t.Errorf("Check() code = %s, want %s", collectErr.Code, want)
+	}
+}
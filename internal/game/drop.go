@@ -0,0 +1,84 @@
+package game
+
+import (
+	"fmt"
+	"time"
+)
+
+// Machine-readable codes of the drop rules, returned to clients as error_code.
+const (
+	DropLevelTooLow       = "DROP_LEVEL_TOO_LOW"
+	DropEggTypeLocked     = "DROP_EGG_TYPE_LOCKED"
+	DropInsufficientCoins = "DROP_INSUFFICIENT_COINS"
+	DropCooldown          = "DROP_COOLDOWN"
+	DropTooManyActive     = "DROP_TOO_MANY_ACTIVE_EGGS"
+	DropTooClose          = "DROP_TOO_CLOSE_TO_EGG"
+	DropExcludedArea      = "DROP_EXCLUDED_AREA"
+)
+
+// DropError is a drop rejected by one of the drop rules.
+type DropError struct {
+	Code    string
+	Message string
+}
+
+func (e *DropError) Error() string {
+	return e.Message
+}
+
+// DropRules are the limits applied to every egg drop. Zero values disable a rule.
+type DropRules struct {
+	Cooldown         time.Duration // minimum time between two drops of a player
+	MaxActiveEggs    int32         // eggs a player may have in the world at once
+	MinSpacingMeters float64       // minimum distance to any other active egg
+}
+
+// DropAttempt is what the rules need to know about a drop.
+type DropAttempt struct {
+	Level         int32
+	MinLevel      int32
+	Locked        bool // the egg type must be unlocked first
+	Coins         int64
+	Cost          int64
+	ActiveEggs    int32
+	LastDroppedAt time.Time
+	Now           time.Time
+}
+
+// Check returns a *DropError for the first rule the attempt breaks.
+// Spacing needs a spatial query and is checked separately with TooClose.
+func (r DropRules) Check(a DropAttempt) error {
+	if a.Level < a.MinLevel {
+		return &DropError{DropLevelTooLow, fmt.Sprintf("Requires level %d, you are level %d", a.MinLevel, a.Level)}
+	}
+	if a.Locked {
+		return &DropError{DropEggTypeLocked, "Unlock this egg type in the shop first"}
+	}
+	if a.Coins < a.Cost {
+		return InsufficientCoins(a.Cost, a.Coins)
+	}
+	if r.Cooldown > 0 {
+		if wait := a.LastDroppedAt.Add(r.Cooldown).Sub(a.Now); wait > 0 {
+			return &DropError{DropCooldown, fmt.Sprintf("Wait %s before dropping another egg", wait.Round(time.Second))}
+		}
+	}
+	if r.MaxActiveEggs > 0 && a.ActiveEggs >= r.MaxActiveEggs {
+		return &DropError{DropTooManyActive, fmt.Sprintf("You already have %d active eggs", a.ActiveEggs)}
+	}
+	return nil
+}
+
+// TooClose is the error for a drop within MinSpacingMeters of another egg.
+func (r DropRules) TooClose() error {
+	return &DropError{DropTooClose, fmt.Sprintf("Eggs must be at least %.0fm apart", r.MinSpacingMeters)}
+}
+
+// ExcludedArea is the error for a drop inside an exclusion zone.
+func ExcludedArea() error {
+	return &DropError{DropExcludedArea, "Eggs cannot be dropped here"}
+}
+
+// InsufficientCoins is the error for a drop the player cannot afford.
+func InsufficientCoins(cost, coins int64) error {
+	return &DropError{DropInsufficientCoins, fmt.Sprintf("Costs %d coins, you have %d", cost, coins)}
+}
@@ -0,0 +1,65 @@
+package game
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDropRulesCheck(t *testing.T) {
+	now := time.Date(2025, 4, 20, 12, 0, 0, 0, time.UTC)
+	rules := DropRules{Cooldown: 5 * time.Minute, MaxActiveEggs: 3}
+	ok := DropAttempt{
+		Level:         5,
+		MinLevel:      3,
+		Coins:         100,
+		Cost:          25,
+		ActiveEggs:    1,
+		LastDroppedAt: now.Add(-10 * time.Minute),
+		Now:           now,
+	}
+
+	tests := []struct {
+		name   string
+		rules  DropRules
+		modify func(a *DropAttempt)
+		want   string // error code, empty when the drop is allowed
+	}{
+		{"allowed", rules, func(a *DropAttempt) {}, ""},
+		{"level too low", rules, func(a *DropAttempt) { a.Level = 2 }, DropLevelTooLow},
+		{"exact level", rules, func(a *DropAttempt) { a.Level = 3 }, ""},
+		{"locked type", rules, func(a *DropAttempt) { a.Locked = true }, DropEggTypeLocked},
+		{"cannot afford", rules, func(a *DropAttempt) { a.Coins = 24 }, DropInsufficientCoins},
+		{"exact coins", rules, func(a *DropAttempt) { a.Coins = 25 }, ""},
+		{"in cooldown", rules, func(a *DropAttempt) { a.LastDroppedAt = now.Add(-time.Minute) }, DropCooldown},
+		{"cooldown just over", rules, func(a *DropAttempt) { a.LastDroppedAt = now.Add(-5 * time.Minute) }, ""},
+		{"never dropped", rules, func(a *DropAttempt) { a.LastDroppedAt = time.Time{} }, ""},
+		{"cooldown disabled", DropRules{MaxActiveEggs: 3}, func(a *DropAttempt) { a.LastDroppedAt = now }, ""},
+		{"active cap reached", rules, func(a *DropAttempt) { a.ActiveEggs = 3 }, DropTooManyActive},
+		{"active cap disabled", DropRules{}, func(a *DropAttempt) { a.ActiveEggs = 100 }, ""},
+		{"level checked first", rules, func(a *DropAttempt) { a.Level = 1; a.Coins = 0; a.ActiveEggs = 3 }, DropLevelTooLow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempt := ok
+			tt.modify(&attempt)
+
+			err := tt.rules.Check(attempt)
+			if tt.want == "" {
+				if err != nil {
+					t.Fatalf("Check() = %v, want nil", err)
+				}
+				return
+			}
+
+			var dropErr *DropError
+			if !errors.As(err, &dropErr) {
+				t.Fatalf("Check() = %v, want a *DropError", err)
+			}
+			if dropErr.Code != tt.want {
+				t.Errorf("Check() code = %s, want %s", dropErr.Code, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package game
+
+import (
+	"errors"
+	"time"
+)
+
+// EggState is a stage in an egg's lifecycle.
+type EggState string
+
+const (
+	EggPlanted    EggState = "PLANTED"
+	EggIncubating EggState = "INCUBATING"
+	EggReady      EggState = "READY"
+	EggHatched    EggState = "HATCHED"
+	EggDecayed    EggState = "DECAYED"
+)
+
+// EggSettleDuration is how long a freshly planted egg waits before it starts incubating.
+const EggSettleDuration = 5 * time.Minute
+
+var ErrInvalidTransition = errors.New("invalid egg state transition")
+
+// transitions lists the allowed moves out of each state.
+var transitions = map[EggState][]EggState{
+	EggPlanted:    {EggIncubating},
+	EggIncubating: {EggReady},
+	EggReady:      {EggHatched, EggDecayed},
+}
+
+// CanTransition reports whether an egg may move from one state to another.
+func CanTransition(from, to EggState) bool {
+	for _, next := range transitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// IsTerminal reports whether no further transitions are possible.
+func (s EggState) IsTerminal() bool {
+	return len(transitions[s]) == 0
+}
+
+// EggLifecycle holds the per-type durations of the timed states.
+type EggLifecycle struct {
+	Incubation time.Duration // INCUBATING -> READY
+	Decay      time.Duration // READY -> DECAYED
+}
+
+// EggSchedule holds when an egg is due to enter each timed state.
+type EggSchedule struct {
+	PlantedAt   time.Time
+	IncubatesAt time.Time
+	ReadyAt     time.Time
+	DecaysAt    time.Time
+}
+
+// NewEggSchedule plans the timed transitions of an egg planted at plantedAt.
+func NewEggSchedule(plantedAt time.Time, lc EggLifecycle) EggSchedule {
+	incubatesAt := plantedAt.Add(EggSettleDuration)
+	readyAt := incubatesAt.Add(lc.Incubation)
+	return EggSchedule{
+		PlantedAt:   plantedAt,
+		IncubatesAt: incubatesAt,
+		ReadyAt:     readyAt,
+		DecaysAt:    readyAt.Add(lc.Decay),
+	}
+}
+
+// Next returns the timed state that follows current and when it is due.
+// ok is false when current has no timed successor.
+func (s EggSchedule) Next(current EggState) (next EggState, at time.Time, ok bool) {
+	switch current {
+	case EggPlanted:
+		return EggIncubating, s.IncubatesAt, true
+	case EggIncubating:
+		return EggReady, s.ReadyAt, true
+	case EggReady:
+		return EggDecayed, s.DecaysAt, true
+	}
+	return "", time.Time{}, false
+}
+
+// Transition is a single state change of an egg.
+type Transition struct {
+	From EggState
+	To   EggState
+	At   time.Time
+}
+
+// Advance returns, in order, the timed transitions that are due by now.
+func (s EggSchedule) Advance(current EggState, now time.Time) []Transition {
+	var due []Transition
+	for {
+		next, at, ok := s.Next(current)
+		if !ok || at.After(now) {
+			return due
+		}
+		due = append(due, Transition{From: current, To: next, At: at})
+		current = next
+	}
+}
+
+// StateAt returns the state an egg in current is in by now, without
+// recording the transitions that led there.
+func (s EggSchedule) StateAt(current EggState, now time.Time) EggState {
+	if due := s.Advance(current, now); len(due) > 0 {
+		return due[len(due)-1].To
+	}
+	return current
+}
+
+// EggReward is what a player earns for hatching an egg.
+type EggReward struct {
+	XP    int64 `json:"xp"`
+	Coins int64 `json:"coins"`
+}
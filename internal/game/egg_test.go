@@ -0,0 +1,54 @@
+package game
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEggScheduleAdvance(t *testing.T) {
+	planted := time.Date(2025, 4, 20, 12, 0, 0, 0, time.UTC)
+	schedule := NewEggSchedule(planted, EggLifecycle{Incubation: time.Hour, Decay: 2 * time.Hour})
+
+	tests := []struct {
+		name    string
+		current EggState
+		now     time.Time
+		want    []EggState // states entered, in order
+	}{
+		{"just planted", EggPlanted, planted, nil},
+		{"settling", EggPlanted, schedule.IncubatesAt.Add(-time.Second), nil},
+		{"incubating", EggPlanted, schedule.IncubatesAt, []EggState{EggIncubating}},
+		{"ready", EggPlanted, schedule.ReadyAt, []EggState{EggIncubating, EggReady}},
+		{"decayed", EggPlanted, schedule.DecaysAt, []EggState{EggIncubating, EggReady, EggDecayed}},
+		{"ready already stored", EggReady, schedule.ReadyAt.Add(time.Minute), nil},
+		{"hatched stays", EggHatched, schedule.DecaysAt.Add(time.Hour), nil},
+		{"decayed stays", EggDecayed, schedule.DecaysAt.Add(time.Hour), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			due := schedule.Advance(tt.current, tt.now)
+			if len(due) != len(tt.want) {
+				t.Fatalf("Advance() = %v, want %d transitions to %v", due, len(tt.want), tt.want)
+			}
+			from := tt.current
+			for i, tr := range due {
+				if tr.From != from || tr.To != tt.want[i] {
+					t.Errorf("transition %d = %s -> %s, want %s -> %s", i, tr.From, tr.To, from, tt.want[i])
+				}
+				if tr.At.After(tt.now) {
+					t.Errorf("transition %d at %v is after %v", i, tr.At, tt.now)
+				}
+				from = tr.To
+			}
+
+			want := tt.current
+			if len(tt.want) > 0 {
+				want = tt.want[len(tt.want)-1]
+			}
+			if got := schedule.StateAt(tt.current, tt.now); got != want {
+				t.Errorf("StateAt() = %s, want %s", got, want)
+			}
+		})
+	}
+}
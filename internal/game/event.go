@@ -0,0 +1,37 @@
+package game
+
+import "time"
+
+// EventKind is something a player did that long-term goals can follow.
+type EventKind string
+
+const (
+	EventEggDropped   EventKind = "EGG_DROPPED"
+	EventEggHatched   EventKind = "EGG_HATCHED"
+	EventEggCollected EventKind = "EGG_COLLECTED"
+)
+
+// Event is a game action, recorded together with the action itself.
+type Event struct {
+	Kind    EventKind
+	EggType string // egg type the action was about, if any
+	Value   int64  // measure of the action, e.g., meters to the farthest other egg dropped
+	At      time.Time
+}
+
+// GoalMetric decides how events move the progress of a goal.
+type GoalMetric string
+
+const (
+	MetricCount GoalMetric = "COUNT" // every event adds one
+	MetricMax   GoalMetric = "MAX"   // the largest event value is kept
+)
+
+// Advance returns the amount an event contributes to a goal measured by m
+// and whether it replaces the progress, when larger, instead of adding to it.
+func (m GoalMetric) Advance(e Event) (amount int64, keepMax bool) {
+	if m == MetricMax {
+		return e.Value, true
+	}
+	return 1, false
+}
@@ -0,0 +1,49 @@
+package game
+
+import "math"
+
+// HintBand is how close a player is to an egg, without saying how close
+// exactly.
+type HintBand string
+
+const (
+	HintHot  HintBand = "HOT"
+	HintWarm HintBand = "WARM"
+	HintCold HintBand = "COLD"
+)
+
+// Upper distance of each band in meters, anything further is cold
+const (
+	hotMeters  = 50
+	warmMeters = 250
+)
+
+// BandAt returns the band of an egg distance meters away.
+func BandAt(distance float64) HintBand {
+	switch {
+	case distance <= hotMeters:
+		return HintHot
+	case distance <= warmMeters:
+		return HintWarm
+	}
+	return HintCold
+}
+
+// HintAreaRadius is the radius in meters of the area hinting at an egg. It
+// is the same in every band, so an egg has one area however close players
+// come, and the areas of several bands cannot be put together to narrow the
+// egg down. It is well within a blur cell, or a hint would tell less than
+// the egg listings do.
+const HintAreaRadius = 150
+
+var compassPoints = [...]string{"N", "NE", "E", "SE", "S", "SW", "W", "NW"}
+
+// CompassPoint returns the nearest of the eight compass points to bearing,
+// in degrees clockwise from north.
+func CompassPoint(bearing float64) string {
+	bearing = math.Mod(bearing, 360)
+	if bearing < 0 {
+		bearing += 360
+	}
+	return compassPoints[int(math.Round(bearing/45))%len(compassPoints)]
+}
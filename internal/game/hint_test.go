@@ -0,0 +1,59 @@
+package game
+
+import "testing"
+
+func TestBandAt(t *testing.T) {
+	tests := []struct {
+		distance float64
+		want     HintBand
+	}{
+		{0, HintHot},
+		{50, HintHot},
+		{50.1, HintWarm},
+		{250, HintWarm},
+		{250.1, HintCold},
+		{10000, HintCold},
+	}
+
+	for _, tt := range tests {
+		if got := BandAt(tt.distance); got != tt.want {
+			t.Errorf("BandAt(%v) = %s, want %s", tt.distance, got, tt.want)
+		}
+	}
+}
+
+func TestCompassPoint(t *testing.T) {
+	tests := []struct {
+		bearing float64
+		want    string
+	}{
+		{0, "N"},
+		{22.4, "N"},
+		{22.5, "NE"},
+		{45, "NE"},
+		{90, "E"},
+		{135, "SE"},
+		{180, "S"},
+		{225, "SW"},
+		{270, "W"},
+		{315, "NW"},
+		{337.4, "NW"},
+		{337.5, "N"},
+		{360, "N"},
+		{405, "NE"},
+		{-45, "NW"},
+		{-90, "W"},
+	}
+
+	for _, tt := range tests {
+		if got := CompassPoint(tt.bearing); got != tt.want {
+			t.Errorf("CompassPoint(%v) = %s, want %s", tt.bearing, got, tt.want)
+		}
+	}
+}
+
+func TestHintAreaNarrowerThanBlur(t *testing.T) {
+	if HintAreaRadius >= BlurMarginMeters {
+		t.Errorf("HintAreaRadius = %d, want less than BlurMarginMeters = %d", HintAreaRadius, BlurMarginMeters)
+	}
+}
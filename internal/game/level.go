@@ -0,0 +1,53 @@
+package game
+
+import "math"
+
+// XPCurve decides how much total XP each level takes. Reaching level n
+// takes Base * (n-1)^Exponent XP, so level 1 starts at 0.
+type XPCurve struct {
+	Base     float64
+	Exponent float64
+	MaxLevel int32
+}
+
+// DefaultXPCurve is used when no curve is configured.
+var DefaultXPCurve = XPCurve{Base: 100, Exponent: 1.5, MaxLevel: 50}
+
+// Threshold returns the total XP needed to reach level.
+func (c XPCurve) Threshold(level int32) int64 {
+	if level <= 1 {
+		return 0
+	}
+	return int64(math.Round(c.Base * math.Pow(float64(level-1), c.Exponent)))
+}
+
+// Level returns the level a player with xp total XP is at.
+func (c XPCurve) Level(xp int64) int32 {
+	level := int32(1)
+	for level < c.MaxLevel && c.Threshold(level+1) <= xp {
+		level++
+	}
+	return level
+}
+
+// LevelProgress is where a player stands within their level.
+type LevelProgress struct {
+	Level       int32 `json:"level"`
+	XPIntoLevel int64 `json:"xp_into_level"`
+	XPForNext   int64 `json:"xp_for_next_level"` // XP the next level takes from the start of this one, 0 at the max level
+	XPToNext    int64 `json:"xp_to_next_level"`  // XP still missing for the next level, 0 at the max level
+}
+
+// Progress returns the level of xp and how far into it the player is.
+func (c XPCurve) Progress(xp int64) LevelProgress {
+	level := c.Level(xp)
+	p := LevelProgress{
+		Level:       level,
+		XPIntoLevel: xp - c.Threshold(level),
+	}
+	if level < c.MaxLevel {
+		p.XPForNext = c.Threshold(level+1) - c.Threshold(level)
+		p.XPToNext = p.XPForNext - p.XPIntoLevel
+	}
+	return p
+}
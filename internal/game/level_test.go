@@ -0,0 +1,72 @@
+package game
+
+import "testing"
+
+func TestXPCurveThreshold(t *testing.T) {
+	tests := []struct {
+		level int32
+		want  int64
+	}{
+		{0, 0},
+		{1, 0},
+		{2, 100},
+		{3, 283}, // 100 * 2^1.5 = 282.8
+		{4, 520}, // 100 * 3^1.5 = 519.6
+	}
+
+	for _, tt := range tests {
+		if got := DefaultXPCurve.Threshold(tt.level); got != tt.want {
+			t.Errorf("Threshold(%d) = %d, want %d", tt.level, got, tt.want)
+		}
+	}
+}
+
+func TestXPCurveLevel(t *testing.T) {
+	linear := XPCurve{Base: 100, Exponent: 1, MaxLevel: 5}
+
+	tests := []struct {
+		name string
+		xp   int64
+		want int32
+	}{
+		{"no xp", 0, 1},
+		{"negative xp", -50, 1},
+		{"just below level 2", 99, 1},
+		{"exactly level 2", 100, 2},
+		{"between levels", 250, 3},
+		{"exactly max level", 400, 5},
+		{"capped at max level", 10_000, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := linear.Level(tt.xp); got != tt.want {
+				t.Errorf("Level(%d) = %d, want %d", tt.xp, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestXPCurveProgress(t *testing.T) {
+	linear := XPCurve{Base: 100, Exponent: 1, MaxLevel: 5}
+
+	tests := []struct {
+		name string
+		xp   int64
+		want LevelProgress
+	}{
+		{"start", 0, LevelProgress{Level: 1, XPIntoLevel: 0, XPForNext: 100, XPToNext: 100}},
+		{"into level 1", 40, LevelProgress{Level: 1, XPIntoLevel: 40, XPForNext: 100, XPToNext: 60}},
+		{"start of level 3", 200, LevelProgress{Level: 3, XPIntoLevel: 0, XPForNext: 100, XPToNext: 100}},
+		{"max level", 400, LevelProgress{Level: 5, XPIntoLevel: 0}},
+		{"past max level", 450, LevelProgress{Level: 5, XPIntoLevel: 50}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := linear.Progress(tt.xp); got != tt.want {
+				t.Errorf("Progress(%d) = %+v, want %+v", tt.xp, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package game
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+	_ "time/tzdata" // the runtime image has no zoneinfo
+)
+
+// playerSettings is the part of players.settings the game reads
+type playerSettings struct {
+	Timezone string `json:"timezone"` // IANA name, e.g., Europe/Berlin
+}
+
+func parseSettings(settings []byte) playerSettings {
+	var s playerSettings
+	_ = json.Unmarshal(settings, &s)
+	return s
+}
+
+// PlayerLocation returns the time zone set in a player's settings, as
+// {"timezone": "Europe/Berlin"}, falling back to UTC when none or an unknown
+// one is set.
+func PlayerLocation(settings []byte) *time.Location {
+	tz := parseSettings(settings).Timezone
+	if tz == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// PlayerRegion returns the region a player competes in, the area of the time
+// zone set in their settings (e.g., Europe for Europe/Berlin). It is empty
+// when no regional time zone is set.
+func PlayerRegion(settings []byte) string {
+	loc := PlayerLocation(settings)
+	region, _, ok := strings.Cut(loc.String(), "/")
+	if !ok {
+		return ""
+	}
+	return region
+}
@@ -0,0 +1,64 @@
+package game
+
+import (
+	"hash/fnv"
+	"math/rand/v2"
+	"slices"
+	"time"
+)
+
+// QuestPeriod is how long a quest lasts before the player gets new ones.
+type QuestPeriod string
+
+const (
+	QuestDaily  QuestPeriod = "DAILY"
+	QuestWeekly QuestPeriod = "WEEKLY"
+)
+
+// QuestPeriods lists every period quests are handed out for.
+var QuestPeriods = []QuestPeriod{QuestDaily, QuestWeekly}
+
+// questsPerPeriod is how many quests a player gets each period.
+var questsPerPeriod = map[QuestPeriod]int{
+	QuestDaily:  3,
+	QuestWeekly: 2,
+}
+
+// Count returns how many quests a player gets each period.
+func (p QuestPeriod) Count() int {
+	return questsPerPeriod[p]
+}
+
+// Bounds returns when the period containing now starts and ends in loc.
+// Days start at local midnight and weeks on Monday at local midnight.
+func (p QuestPeriod) Bounds(now time.Time, loc *time.Location) (start, end time.Time) {
+	local := now.In(loc)
+	start = time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+	if p == QuestWeekly {
+		start = start.AddDate(0, 0, -((int(local.Weekday()) + 6) % 7))
+		return start, start.AddDate(0, 0, 7)
+	}
+	return start, start.AddDate(0, 0, 1)
+}
+
+// SelectQuests picks up to n distinct indexes of weights, favoring larger
+// weights. The pick only depends on key, so a player gets the same quests
+// for as long as the key, made of player and period start, stays the same.
+func SelectQuests(key string, weights []int32, n int) []int {
+	h := fnv.New64a()
+	h.Write([]byte(key))
+	r := rand.New(rand.NewPCG(h.Sum64(), 0))
+
+	left := slices.Clone(weights)
+	picked := make([]int, 0, n)
+	for len(picked) < n {
+		i := weightedIndex(left, r.Int64N)
+		if i < 0 {
+			break
+		}
+		picked = append(picked, i)
+		left[i] = 0
+	}
+	slices.Sort(picked)
+	return picked
+}
@@ -0,0 +1,36 @@
+package game
+
+import "math/rand/v2"
+
+// WeightedIndex picks an index at random, each index i being chosen with
+// probability weights[i] / sum(weights). Non-positive weights are never
+// chosen. It returns -1 when no weight is positive.
+func WeightedIndex(weights []int32) int {
+	return weightedIndex(weights, rand.Int64N)
+}
+
+// weightedIndex is WeightedIndex rolling with int64n, which returns a number
+// in [0, n).
+func weightedIndex(weights []int32, int64n func(n int64) int64) int {
+	var total int64
+	for _, w := range weights {
+		if w > 0 {
+			total += int64(w)
+		}
+	}
+	if total == 0 {
+		return -1
+	}
+
+	roll := int64n(total)
+	for i, w := range weights {
+		if w <= 0 {
+			continue
+		}
+		if roll < int64(w) {
+			return i
+		}
+		roll -= int64(w)
+	}
+	return -1
+}
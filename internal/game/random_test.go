@@ -0,0 +1,54 @@
+package game
+
+import "testing"
+
+func TestWeightedIndex(t *testing.T) {
+	tests := []struct {
+		name    string
+		weights []int32
+		roll    int64
+		want    int
+	}{
+		{"no weights", nil, 0, -1},
+		{"all zero", []int32{0, 0}, 0, -1},
+		{"all negative", []int32{-5, -1}, 0, -1},
+		{"single", []int32{7}, 6, 0},
+		{"first bucket start", []int32{70, 30}, 0, 0},
+		{"first bucket end", []int32{70, 30}, 69, 0},
+		{"second bucket start", []int32{70, 30}, 70, 1},
+		{"second bucket end", []int32{70, 30}, 99, 1},
+		{"skips zero weight", []int32{10, 0, 5}, 10, 2},
+		{"skips negative weight", []int32{-3, 4}, 0, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var total int64
+			got := weightedIndex(tt.weights, func(n int64) int64 {
+				total = n
+				return tt.roll
+			})
+			if got != tt.want {
+				t.Errorf("weightedIndex(%v) with roll %d = %d, want %d", tt.weights, tt.roll, got, tt.want)
+			}
+			if got >= 0 && tt.roll >= total {
+				t.Errorf("roll %d is outside the total weight %d", tt.roll, total)
+			}
+		})
+	}
+}
+
+func TestWeightedIndexDistribution(t *testing.T) {
+	weights := []int32{1, 0, 3}
+	counts := make([]int, len(weights))
+	for roll := int64(0); roll < 4; roll++ {
+		counts[weightedIndex(weights, func(int64) int64 { return roll })]++
+	}
+
+	want := []int{1, 0, 3}
+	for i := range want {
+		if counts[i] != want[i] {
+			t.Errorf("index %d chosen %d times over every roll, want %d", i, counts[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,12 @@
+package game
+
+import "time"
+
+// SpawnRules are the limits of the world egg spawner.
+type SpawnRules struct {
+	TTL              time.Duration // how long a spawn waits to be collected
+	ActiveWindow     time.Duration // players who reported their location this recently get spawns around them
+	PlayerRadius     float64       // meters around an active player spawns are placed within
+	PlayerMaxActive  int32         // spawns allowed within PlayerRadius of an active player
+	MinSpacingMeters float64       // minimum distance to any other egg or spawn
+}
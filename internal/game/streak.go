@@ -0,0 +1,62 @@
+package game
+
+import "time"
+
+// RewardCycle is how many days the daily rewards calendar has before it
+// starts over.
+const RewardCycle = 7
+
+// Streak is a run of consecutive days a player was active on. Days are
+// dates in the player's time zone, see LocalDay.
+type Streak struct {
+	Current int32
+	Longest int32
+	LastDay time.Time // zero before the first active day
+	Freezes int32     // missed days the streak survives
+}
+
+// LocalDay returns the date t falls on in loc, as midnight UTC so days can
+// be compared and counted without time zone transitions getting in the way.
+func LocalDay(t time.Time, loc *time.Location) time.Time {
+	local := t.In(loc)
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// Visit returns the streak after activity on day and how many freezes were
+// used up to bridge the days missed since the last one. Days missed beyond
+// the freezes left reset the streak, without using any.
+func (s Streak) Visit(day time.Time) (Streak, int32) {
+	if !s.LastDay.IsZero() && !day.After(s.LastDay) {
+		return s, 0
+	}
+
+	var used int32
+	if missed := s.missedBefore(day); missed < 0 || missed > s.Freezes {
+		s.Current = 1
+	} else {
+		used = missed
+		s.Freezes -= missed
+		s.Current++
+	}
+	s.LastDay = day
+	s.Longest = max(s.Longest, s.Current)
+	return s, used
+}
+
+// missedBefore returns how many days passed between the last active day and
+// day, -1 before the first active day.
+func (s Streak) missedBefore(day time.Time) int32 {
+	if s.LastDay.IsZero() {
+		return -1
+	}
+	return int32(day.Sub(s.LastDay)/(24*time.Hour)) - 1
+}
+
+// RewardDay returns the calendar day a streak of current days earns, from 1
+// to RewardCycle.
+func RewardDay(current int32) int32 {
+	if current < 1 {
+		return 1
+	}
+	return (current-1)%RewardCycle + 1
+}
@@ -0,0 +1,116 @@
+package game
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStreakVisit(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2025, 4, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name     string
+		streak   Streak
+		visit    time.Time
+		want     Streak
+		wantUsed int32
+	}{
+		{
+			name:   "first visit",
+			streak: Streak{},
+			visit:  day(10),
+			want:   Streak{Current: 1, Longest: 1, LastDay: day(10)},
+		},
+		{
+			name:   "next day",
+			streak: Streak{Current: 3, Longest: 3, LastDay: day(10)},
+			visit:  day(11),
+			want:   Streak{Current: 4, Longest: 4, LastDay: day(11)},
+		},
+		{
+			name:   "same day again",
+			streak: Streak{Current: 3, Longest: 5, LastDay: day(10)},
+			visit:  day(10),
+			want:   Streak{Current: 3, Longest: 5, LastDay: day(10)},
+		},
+		{
+			name:   "earlier day",
+			streak: Streak{Current: 3, Longest: 5, LastDay: day(10)},
+			visit:  day(9),
+			want:   Streak{Current: 3, Longest: 5, LastDay: day(10)},
+		},
+		{
+			name:   "missed a day without freezes",
+			streak: Streak{Current: 3, Longest: 5, LastDay: day(10)},
+			visit:  day(12),
+			want:   Streak{Current: 1, Longest: 5, LastDay: day(12)},
+		},
+		{
+			name:     "missed a day with a freeze",
+			streak:   Streak{Current: 3, Longest: 3, LastDay: day(10), Freezes: 2},
+			visit:    day(12),
+			want:     Streak{Current: 4, Longest: 4, LastDay: day(12), Freezes: 1},
+			wantUsed: 1,
+		},
+		{
+			name:     "missed as many days as freezes",
+			streak:   Streak{Current: 3, Longest: 3, LastDay: day(10), Freezes: 2},
+			visit:    day(13),
+			want:     Streak{Current: 4, Longest: 4, LastDay: day(13)},
+			wantUsed: 2,
+		},
+		{
+			name:   "missed more days than freezes keeps them",
+			streak: Streak{Current: 3, Longest: 3, LastDay: day(10), Freezes: 2},
+			visit:  day(14),
+			want:   Streak{Current: 1, Longest: 3, LastDay: day(14), Freezes: 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, used := tt.streak.Visit(tt.visit)
+			if got != tt.want {
+				t.Errorf("Visit() = %+v, want %+v", got, tt.want)
+			}
+			if used != tt.wantUsed {
+				t.Errorf("Visit() used %d freezes, want %d", used, tt.wantUsed)
+			}
+		})
+	}
+}
+
+func TestLocalDay(t *testing.T) {
+	accra := time.FixedZone("GMT", 0)
+	tokyo := time.FixedZone("JST", 9*60*60)
+	at := time.Date(2025, 4, 10, 20, 30, 0, 0, time.UTC)
+
+	if got, want := LocalDay(at, accra), time.Date(2025, 4, 10, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("LocalDay(GMT) = %v, want %v", got, want)
+	}
+	if got, want := LocalDay(at, tokyo), time.Date(2025, 4, 11, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("LocalDay(JST) = %v, want %v", got, want)
+	}
+}
+
+func TestRewardDay(t *testing.T) {
+	tests := []struct {
+		current int32
+		want    int32
+	}{
+		{0, 1},
+		{1, 1},
+		{6, 6},
+		{7, 7},
+		{8, 1},
+		{15, 1},
+	}
+
+	for _, tt := range tests {
+		if got := RewardDay(tt.current); got != tt.want {
+			t.Errorf("RewardDay(%d) = %d, want %d", tt.current, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package game
+
+import "strings"
+
+// ToolKind is what a tool does when equipped.
+type ToolKind string
+
+const (
+	ToolRadar  ToolKind = "RADAR"  // extends the nearby egg search
+	ToolShovel ToolKind = "SHOVEL" // extends the hatch reach
+	ToolShield ToolKind = "SHIELD" // protects dropped eggs from other players
+)
+
+// ToolSlot is where a tool is equipped. A player has at most one tool equipped per slot.
+type ToolSlot string
+
+const (
+	SlotDevice ToolSlot = "DEVICE"
+	SlotHand   ToolSlot = "HAND"
+	SlotBody   ToolSlot = "BODY"
+)
+
+// ToolSpec describes a tool kind.
+type ToolSpec struct {
+	Slot ToolSlot
+	Wear int32 // durability consumed per use
+}
+
+var toolSpecs = map[ToolKind]ToolSpec{
+	ToolRadar:  {Slot: SlotDevice, Wear: 1},
+	ToolShovel: {Slot: SlotHand, Wear: 5},
+	ToolShield: {Slot: SlotBody, Wear: 10},
+}
+
+// Tool effects while equipped
+const (
+	RadarRangeMultiplier = 2.0  // nearby search radius cap is multiplied by this
+	ShovelReachMeters    = 20.0 // added to the hatch radius
+)
+
+// ParseToolKind returns the tool kind named by s, ignoring case.
+func ParseToolKind(s string) (ToolKind, bool) {
+	kind := ToolKind(strings.ToUpper(strings.TrimSpace(s)))
+	_, ok := toolSpecs[kind]
+	return kind, ok
+}
+
+// Spec returns the slot and wear of the kind.
+func (k ToolKind) Spec() ToolSpec {
+	return toolSpecs[k]
+}
@@ -0,0 +1,341 @@
+package leaderboard
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	db "github.com/0xdbb/eggsplore/internal/database/sqlc"
+	"github.com/0xdbb/eggsplore/internal/game"
+	"github.com/0xdbb/eggsplore/util"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Metric is what a board ranks players by
+type Metric string
+
+const (
+	MetricXP          Metric = "xp"           // XP earned
+	MetricEggsHatched Metric = "eggs_hatched" // eggs hatched
+	MetricDistance    Metric = "distance"     // meters travelled, from reported locations
+)
+
+// Metrics lists every metric
+var Metrics = []Metric{MetricXP, MetricEggsHatched, MetricDistance}
+
+// Scope is which players and which time a board covers
+type Scope string
+
+const (
+	ScopeGlobal Scope = "global" // everyone, all time
+	ScopeWeekly Scope = "weekly" // everyone, since Monday 00:00 UTC
+	ScopeRegion Scope = "region" // players of one region, all time
+)
+
+// regionsKey is where the store tags every player with the region board they
+// are on, so they can be taken off it when their region changes
+const regionsKey = "leaderboard:regions"
+
+// ErrNoRegion is returned for a region board without a region
+var ErrNoRegion = errors.New("region board without a region")
+
+// Board is one leaderboard
+type Board struct {
+	Metric Metric
+	Scope  Scope
+	Region string // only for ScopeRegion, see game.PlayerRegion
+}
+
+// ParseMetric returns the metric named name
+func ParseMetric(name string) (Metric, bool) {
+	for _, m := range Metrics {
+		if string(m) == name {
+			return m, true
+		}
+	}
+	return "", false
+}
+
+// weekBounds returns the weekly period now is in
+func weekBounds(now time.Time) (start, end time.Time) {
+	return game.QuestWeekly.Bounds(now, time.UTC)
+}
+
+// key returns the store key of the board at now, and when the board expires.
+// Weekly boards are kept for a day after their week ended.
+func (b Board) key(now time.Time) (string, time.Time, error) {
+	prefix := "leaderboard:" + string(b.Metric) + ":"
+	switch b.Scope {
+	case ScopeGlobal:
+		return prefix + "global", time.Time{}, nil
+	case ScopeWeekly:
+		start, end := weekBounds(now)
+		return prefix + "weekly:" + start.Format(time.DateOnly), end.AddDate(0, 0, 1), nil
+	case ScopeRegion:
+		if b.Region == "" {
+			return "", time.Time{}, ErrNoRegion
+		}
+		return regionPrefix(b.Metric) + b.Region, time.Time{}, nil
+	}
+	return "", time.Time{}, errors.New("unknown leaderboard scope " + string(b.Scope))
+}
+
+// regionPrefix is what the keys of the region boards of metric start with
+func regionPrefix(metric Metric) string {
+	return "leaderboard:" + string(metric) + ":region:"
+}
+
+// scoreLister reads scores and queued updates from Postgres, it is the
+// *db.Service outside tests
+type scoreLister interface {
+	ListLeaderboardScores(ctx context.Context, arg db.ListLeaderboardScoresParams) ([]db.ListLeaderboardScoresRow, error)
+	ListLeaderboardUpdates(ctx context.Context, limit int32) ([]db.LeaderboardUpdates, error)
+	DeleteLeaderboardUpdate(ctx context.Context, arg db.DeleteLeaderboardUpdateParams) error
+}
+
+// flushBatch is how many queued updates one flush writes at most
+const flushBatch = 100
+
+// Leaderboards ranks players on every board. Postgres holds the scores, the
+// store only keeps them ranked, so a lost store is rebuilt with Rebuild.
+type Leaderboards struct {
+	store Store
+	db    scoreLister
+}
+
+// New returns leaderboards kept in store
+func New(store Store, service *db.Service) *Leaderboards {
+	return &Leaderboards{store: store, db: service}
+}
+
+// score returns the score of a player on a board of metric
+func score(row db.ListLeaderboardScoresRow, metric Metric) float64 {
+	switch metric {
+	case MetricXP:
+		return float64(row.Xp)
+	case MetricEggsHatched:
+		return float64(row.EggsHatched)
+	case MetricDistance:
+		return row.Distance
+	}
+	return 0
+}
+
+// Rebuild recomputes every board of the current period from Postgres. Boards
+// left from players who moved to another region are cleared.
+func (l *Leaderboards) Rebuild(ctx context.Context, now time.Time) error {
+	allTime, err := l.db.ListLeaderboardScores(ctx, db.ListLeaderboardScoresParams{})
+	if err != nil {
+		return err
+	}
+	weekStart, _ := weekBounds(now)
+	weekly, err := l.db.ListLeaderboardScores(ctx, db.ListLeaderboardScoresParams{Since: weekStart})
+	if err != nil {
+		return err
+	}
+
+	regions := map[string]string{}
+	for _, row := range allTime {
+		regions[row.PlayerID.String()] = game.PlayerRegion(row.Settings)
+	}
+
+	for _, metric := range Metrics {
+		boards := map[Board]map[string]float64{
+			{Metric: metric, Scope: ScopeGlobal}: {},
+			{Metric: metric, Scope: ScopeWeekly}: {},
+		}
+		for _, row := range allTime {
+			member, s := row.PlayerID.String(), score(row, metric)
+			boards[Board{Metric: metric, Scope: ScopeGlobal}][member] = s
+
+			if region := regions[member]; region != "" {
+				board := Board{Metric: metric, Scope: ScopeRegion, Region: region}
+				if boards[board] == nil {
+					boards[board] = map[string]float64{}
+				}
+				boards[board][member] = s
+			}
+		}
+		for _, row := range weekly {
+			boards[Board{Metric: metric, Scope: ScopeWeekly}][row.PlayerID.String()] = score(row, metric)
+		}
+
+		// Region boards nobody is on any more are cleared, the others are
+		// replaced below
+		stale, err := l.store.Keys(ctx, regionPrefix(metric))
+		if err != nil {
+			return err
+		}
+		for _, key := range stale {
+			region := strings.TrimPrefix(key, regionPrefix(metric))
+			if _, ok := boards[Board{Metric: metric, Scope: ScopeRegion, Region: region}]; ok {
+				continue
+			}
+			if err := l.store.Replace(ctx, key, nil, time.Time{}); err != nil {
+				return err
+			}
+		}
+
+		for board, scores := range boards {
+			key, expireAt, err := board.key(now)
+			if err != nil {
+				return err
+			}
+			if err := l.store.Replace(ctx, key, scores, expireAt); err != nil {
+				return err
+			}
+		}
+	}
+	return l.store.ReplaceTags(ctx, regionsKey, regions)
+}
+
+// Update recomputes a player's scores on every board they are on. Scores are
+// set rather than incremented, so a missed update is fixed by the next one.
+// A player whose region changed is taken off the board of the old one.
+func (l *Leaderboards) Update(ctx context.Context, playerID uuid.UUID, now time.Time) error {
+	player := pgtype.UUID{Bytes: playerID, Valid: true}
+	allTime, err := l.db.ListLeaderboardScores(ctx, db.ListLeaderboardScoresParams{PlayerID: player})
+	if err != nil || len(allTime) == 0 {
+		return err
+	}
+	weekStart, _ := weekBounds(now)
+	weekly, err := l.db.ListLeaderboardScores(ctx, db.ListLeaderboardScoresParams{Since: weekStart, PlayerID: player})
+	if err != nil || len(weekly) == 0 {
+		return err
+	}
+
+	member := playerID.String()
+	region := game.PlayerRegion(allTime[0].Settings)
+	previous, err := l.store.Tag(ctx, regionsKey, member)
+	if err != nil {
+		return err
+	}
+
+	for _, metric := range Metrics {
+		if previous != "" && previous != region {
+			key, _, err := Board{Metric: metric, Scope: ScopeRegion, Region: previous}.key(now)
+			if err != nil {
+				return err
+			}
+			if err := l.store.Remove(ctx, key, member); err != nil {
+				return err
+			}
+		}
+
+		scores := map[Board]float64{
+			{Metric: metric, Scope: ScopeGlobal}: score(allTime[0], metric),
+			{Metric: metric, Scope: ScopeWeekly}: score(weekly[0], metric),
+		}
+		if region != "" {
+			scores[Board{Metric: metric, Scope: ScopeRegion, Region: region}] = score(allTime[0], metric)
+		}
+
+		for board, s := range scores {
+			key, expireAt, err := board.key(now)
+			if err != nil {
+				return err
+			}
+			if err := l.store.Set(ctx, key, member, s, expireAt); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Tagged last, so a failed update retries the removal next time
+	if previous == region {
+		return nil
+	}
+	return l.store.SetTag(ctx, regionsKey, member, region)
+}
+
+// Flush writes the scores of players queued for an update, oldest first, and
+// returns how many it wrote. The transaction changing a score queues the
+// update, so scores reach the store even when the request that changed them
+// did not finish. An update that fails stays queued for the next flush.
+func (l *Leaderboards) Flush(ctx context.Context, now time.Time) (int, error) {
+	queued, err := l.db.ListLeaderboardUpdates(ctx, flushBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	written := 0
+	var failed error
+	for _, u := range queued {
+		err := l.Update(ctx, u.PlayerID, now)
+		if err == nil {
+			err = l.db.DeleteLeaderboardUpdate(ctx, db.DeleteLeaderboardUpdateParams{
+				PlayerID: u.PlayerID,
+				QueuedAt: u.QueuedAt,
+			})
+		}
+		if err != nil {
+			failed = cmp.Or(failed, err)
+			continue
+		}
+		written++
+	}
+	return written, failed
+}
+
+// Run flushes queued updates every interval until ctx is done. Failed
+// flushes are logged and retried on the next one.
+func (l *Leaderboards) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			written, err := l.Flush(ctx, util.Now())
+			if err != nil && ctx.Err() == nil {
+				log.Printf("⚠️ Leaderboard flush failed: %v", err)
+			}
+			if err != nil || written < flushBatch {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Page returns up to limit entries of board from offset, and how many
+// players are on it
+func (l *Leaderboards) Page(ctx context.Context, board Board, now time.Time, offset, limit int64) ([]Entry, int64, error) {
+	key, _, err := board.key(now)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	entries, err := l.store.Range(ctx, key, offset, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+	total, err := l.store.Count(ctx, key)
+	return entries, total, err
+}
+
+// Around returns a player's entry on board and up to n entries on either
+// side of it. ok is false when the player is not on the board.
+func (l *Leaderboards) Around(ctx context.Context, board Board, now time.Time, playerID uuid.UUID, n int64) (me Entry, entries []Entry, ok bool, err error) {
+	key, _, err := board.key(now)
+	if err != nil {
+		return me, nil, false, err
+	}
+
+	me, ok, err = l.store.Rank(ctx, key, playerID.String())
+	if err != nil || !ok {
+		return me, []Entry{}, ok, err
+	}
+
+	offset := max(me.Rank-1-n, 0)
+	entries, err = l.store.Range(ctx, key, offset, me.Rank+n-offset)
+	return me, entries, true, err
+}
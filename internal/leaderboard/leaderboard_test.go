@@ -0,0 +1,355 @@
+package leaderboard
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	db "github.com/0xdbb/eggsplore/internal/database/sqlc"
+	"github.com/google/uuid"
+)
+
+// fakeScores stands in for Postgres, weekly rows are returned when a start
+// of the week is asked for
+type fakeScores struct {
+	allTime []db.ListLeaderboardScoresRow
+	weekly  []db.ListLeaderboardScoresRow
+	queued  []db.LeaderboardUpdates
+}
+
+func (f *fakeScores) ListLeaderboardScores(_ context.Context, arg db.ListLeaderboardScoresParams) ([]db.ListLeaderboardScoresRow, error) {
+	rows := f.allTime
+	if !arg.Since.IsZero() {
+		rows = f.weekly
+	}
+
+	items := []db.ListLeaderboardScoresRow{}
+	for _, row := range rows {
+		if !arg.PlayerID.Valid || row.PlayerID == arg.PlayerID.Bytes {
+			items = append(items, row)
+		}
+	}
+	return items, nil
+}
+
+func (f *fakeScores) ListLeaderboardUpdates(_ context.Context, limit int32) ([]db.LeaderboardUpdates, error) {
+	return slices.Clone(f.queued[:min(int(limit), len(f.queued))]), nil
+}
+
+func (f *fakeScores) DeleteLeaderboardUpdate(_ context.Context, arg db.DeleteLeaderboardUpdateParams) error {
+	f.queued = slices.DeleteFunc(f.queued, func(u db.LeaderboardUpdates) bool {
+		return u.PlayerID == arg.PlayerID && u.QueuedAt.Equal(arg.QueuedAt)
+	})
+	return nil
+}
+
+// testNow is the real time, as the memory store expires boards by it
+var testNow = time.Now().UTC()
+
+func members(entries []Entry) []string {
+	names := make([]string, 0, len(entries))
+	for _, e := range entries {
+		names = append(names, e.Member)
+	}
+	return names
+}
+
+// testBoards returns leaderboards with n players on the global XP board,
+// ranked in the order of the returned ids
+func testBoards(t *testing.T, n int) (*Leaderboards, []uuid.UUID) {
+	t.Helper()
+	store := NewMemoryStore()
+	ids := make([]uuid.UUID, n)
+	scores := map[string]float64{}
+	for i := range ids {
+		ids[i] = uuid.New()
+		scores[ids[i].String()] = float64((n - i) * 100)
+	}
+
+	key, _, _ := Board{Metric: MetricXP, Scope: ScopeGlobal}.key(testNow)
+	if err := store.Replace(context.Background(), key, scores, time.Time{}); err != nil {
+		t.Fatal(err)
+	}
+	return &Leaderboards{store: store, db: &fakeScores{}}, ids
+}
+
+func TestLeaderboardsPage(t *testing.T) {
+	boards, ids := testBoards(t, 5)
+	global := Board{Metric: MetricXP, Scope: ScopeGlobal}
+
+	tests := []struct {
+		name          string
+		board         Board
+		offset, limit int64
+		want          []uuid.UUID
+		wantErr       error
+	}{
+		{name: "first page", board: global, offset: 0, limit: 2, want: ids[:2]},
+		{name: "last page", board: global, offset: 4, limit: 2, want: ids[4:]},
+		{name: "past the end", board: global, offset: 10, limit: 2, want: nil},
+		{name: "empty board", board: Board{Metric: MetricDistance, Scope: ScopeWeekly}, offset: 0, limit: 2, want: nil},
+		{name: "region board without region", board: Board{Metric: MetricXP, Scope: ScopeRegion}, wantErr: ErrNoRegion},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries, total, err := boards.Page(context.Background(), tt.board, testNow, tt.offset, tt.limit)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Page() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			want := []string{}
+			for _, id := range tt.want {
+				want = append(want, id.String())
+			}
+			if got := members(entries); !slices.Equal(got, want) {
+				t.Errorf("Page() members = %v, want %v", got, want)
+			}
+			if tt.board == global && total != 5 {
+				t.Errorf("Page() total = %d, want 5", total)
+			}
+		})
+	}
+}
+
+func TestLeaderboardsAround(t *testing.T) {
+	boards, ids := testBoards(t, 5)
+	global := Board{Metric: MetricXP, Scope: ScopeGlobal}
+
+	tests := []struct {
+		name     string
+		player   uuid.UUID
+		n        int64
+		wantRank int64
+		want     []uuid.UUID
+		wantOK   bool
+	}{
+		{name: "middle", player: ids[2], n: 1, wantRank: 3, want: ids[1:4], wantOK: true},
+		{name: "top", player: ids[0], n: 2, wantRank: 1, want: ids[:3], wantOK: true},
+		{name: "bottom", player: ids[4], n: 2, wantRank: 5, want: ids[2:], wantOK: true},
+		{name: "not on the board", player: uuid.New(), n: 2, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			me, entries, ok, err := boards.Around(context.Background(), global, testNow, tt.player, tt.n)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ok != tt.wantOK {
+				t.Fatalf("Around() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+
+			if me.Member != tt.player.String() || me.Rank != tt.wantRank {
+				t.Errorf("Around() me = %v, want %s at rank %d", me, tt.player, tt.wantRank)
+			}
+			want := []string{}
+			for _, id := range tt.want {
+				want = append(want, id.String())
+			}
+			if got := members(entries); !slices.Equal(got, want) {
+				t.Errorf("Around() members = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestLeaderboardsUpdate(t *testing.T) {
+	player := uuid.New()
+	row := func(timezone string, xp int64) db.ListLeaderboardScoresRow {
+		return db.ListLeaderboardScoresRow{
+			PlayerID: player,
+			Settings: []byte(`{"timezone": "` + timezone + `"}`),
+			Xp:       xp,
+		}
+	}
+
+	tests := []struct {
+		name       string
+		before     db.ListLeaderboardScoresRow // scores at the first update, none when zero
+		after      db.ListLeaderboardScoresRow
+		wantScore  float64
+		wantRegion string // region board the player is on after the update
+		notOn      string // region board the player must be gone from
+	}{
+		{
+			name:       "new player",
+			after:      row("Europe/Berlin", 100),
+			wantScore:  100,
+			wantRegion: "Europe",
+		},
+		{
+			name:       "score goes up",
+			before:     row("Europe/Berlin", 100),
+			after:      row("Europe/Berlin", 250),
+			wantScore:  250,
+			wantRegion: "Europe",
+		},
+		{
+			name:       "region changes",
+			before:     row("Europe/Berlin", 100),
+			after:      row("America/New_York", 120),
+			wantScore:  120,
+			wantRegion: "America",
+			notOn:      "Europe",
+		},
+		{
+			name:      "region cleared",
+			before:    row("Africa/Accra", 100),
+			after:     row("", 120),
+			wantScore: 120,
+			notOn:     "Africa",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			scores := &fakeScores{}
+			store := NewMemoryStore()
+			boards := &Leaderboards{store: store, db: scores}
+
+			if tt.before.PlayerID != uuid.Nil {
+				scores.allTime = []db.ListLeaderboardScoresRow{tt.before}
+				scores.weekly = []db.ListLeaderboardScoresRow{tt.before}
+				if err := boards.Update(ctx, player, testNow); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			scores.allTime = []db.ListLeaderboardScoresRow{tt.after}
+			scores.weekly = []db.ListLeaderboardScoresRow{tt.after}
+			if err := boards.Update(ctx, player, testNow); err != nil {
+				t.Fatal(err)
+			}
+
+			onBoard := []Board{
+				{Metric: MetricXP, Scope: ScopeGlobal},
+				{Metric: MetricXP, Scope: ScopeWeekly},
+			}
+			if tt.wantRegion != "" {
+				onBoard = append(onBoard, Board{Metric: MetricXP, Scope: ScopeRegion, Region: tt.wantRegion})
+			}
+			for _, board := range onBoard {
+				me, _, ok, err := boards.Around(ctx, board, testNow, player, 0)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !ok || me.Score != tt.wantScore {
+					t.Errorf("%s %s board: on it %v with %v, want %v", board.Scope, board.Region, ok, me.Score, tt.wantScore)
+				}
+			}
+
+			if tt.notOn != "" {
+				for _, metric := range Metrics {
+					board := Board{Metric: metric, Scope: ScopeRegion, Region: tt.notOn}
+					if _, _, ok, _ := boards.Around(ctx, board, testNow, player, 0); ok {
+						t.Errorf("still on the %s %s board", tt.notOn, metric)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestLeaderboardsRebuild(t *testing.T) {
+	ctx := context.Background()
+	moved, left := uuid.New(), uuid.New()
+	rows := []db.ListLeaderboardScoresRow{
+		{PlayerID: moved, Settings: []byte(`{"timezone": "Europe/Berlin"}`), Xp: 100},
+	}
+	store := NewMemoryStore()
+	boards := &Leaderboards{store: store, db: &fakeScores{allTime: rows, weekly: rows}}
+
+	// Left over from before: the moved player in their old region and a
+	// player who is gone
+	africa := Board{Metric: MetricXP, Scope: ScopeRegion, Region: "Africa"}
+	key, _, _ := africa.key(testNow)
+	stale := map[string]float64{moved.String(): 80, left.String(): 50}
+	if err := store.Replace(ctx, key, stale, time.Time{}); err != nil {
+		t.Fatal(err)
+	}
+	weekly := Board{Metric: MetricXP, Scope: ScopeWeekly}
+	key, _, _ = weekly.key(testNow)
+	if err := store.Replace(ctx, key, map[string]float64{left.String(): 50}, time.Time{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := boards.Rebuild(ctx, testNow); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		board Board
+		want  []string
+	}{
+		{Board{Metric: MetricXP, Scope: ScopeGlobal}, []string{moved.String()}},
+		{weekly, []string{moved.String()}},
+		{Board{Metric: MetricXP, Scope: ScopeRegion, Region: "Europe"}, []string{moved.String()}},
+		{africa, []string{}},
+	}
+	for _, tt := range tests {
+		entries, _, err := boards.Page(ctx, tt.board, testNow, 0, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := members(entries); !slices.Equal(got, tt.want) {
+			t.Errorf("%s %s board = %v, want %v", tt.board.Scope, tt.board.Region, got, tt.want)
+		}
+	}
+}
+
+func TestLeaderboardsFlush(t *testing.T) {
+	ctx := context.Background()
+	written, requeued := uuid.New(), uuid.New()
+	rows := []db.ListLeaderboardScoresRow{{PlayerID: written, Xp: 100}, {PlayerID: requeued, Xp: 50}}
+	first := db.LeaderboardUpdates{PlayerID: written, QueuedAt: testNow.Add(-time.Minute)}
+
+	// The flush lists the requeued player's older update, a change committed
+	// meanwhile queued them again
+	scores := &staleUpdates{
+		fakeScores: &fakeScores{
+			allTime: rows,
+			weekly:  rows,
+			queued:  []db.LeaderboardUpdates{first, {PlayerID: requeued, QueuedAt: testNow}},
+		},
+		listed: []db.LeaderboardUpdates{first, {PlayerID: requeued, QueuedAt: testNow.Add(-time.Second)}},
+	}
+	boards := &Leaderboards{store: NewMemoryStore(), db: scores}
+
+	n, err := boards.Flush(ctx, testNow)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Errorf("Flush() wrote %d updates, want 2", n)
+	}
+
+	for _, id := range []uuid.UUID{written, requeued} {
+		if _, _, ok, _ := boards.Around(ctx, Board{Metric: MetricXP, Scope: ScopeGlobal}, testNow, id, 0); !ok {
+			t.Errorf("player %s not on the global board", id)
+		}
+	}
+	if len(scores.queued) != 1 || scores.queued[0].PlayerID != requeued {
+		t.Errorf("queued after flush = %v, want only the update queued again", scores.queued)
+	}
+}
+
+// staleUpdates lists the updates queued when a flush started, whatever was
+// queued since
+type staleUpdates struct {
+	*fakeScores
+	listed []db.LeaderboardUpdates
+}
+
+func (s *staleUpdates) ListLeaderboardUpdates(context.Context, int32) ([]db.LeaderboardUpdates, error) {
+	return s.listed, nil
+}
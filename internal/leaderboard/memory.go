@@ -0,0 +1,193 @@
+package leaderboard
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MemoryStore keeps boards in process memory. It stands in for Redis in
+// development and tests; boards are lost on restart and not shared between
+// instances.
+type MemoryStore struct {
+	mu     sync.Mutex
+	boards map[string]*memoryBoard
+	tags   map[string]map[string]string
+}
+
+type memoryBoard struct {
+	scores   map[string]float64
+	expireAt time.Time
+}
+
+// NewMemoryStore returns an empty in-memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{boards: map[string]*memoryBoard{}, tags: map[string]map[string]string{}}
+}
+
+func (s *MemoryStore) Replace(_ context.Context, key string, scores map[string]float64, expireAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(scores) == 0 {
+		delete(s.boards, key)
+		return nil
+	}
+
+	board := &memoryBoard{scores: make(map[string]float64, len(scores)), expireAt: expireAt}
+	for member, score := range scores {
+		board.scores[member] = score
+	}
+	s.boards[key] = board
+	return nil
+}
+
+func (s *MemoryStore) Set(_ context.Context, key string, member string, score float64, expireAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	board := s.board(key)
+	if board == nil {
+		board = &memoryBoard{scores: map[string]float64{}}
+		s.boards[key] = board
+	}
+	board.scores[member] = score
+	if !expireAt.IsZero() {
+		board.expireAt = expireAt
+	}
+	return nil
+}
+
+func (s *MemoryStore) Range(_ context.Context, key string, offset, limit int64) ([]Entry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ranked := s.ranked(key)
+	if offset >= int64(len(ranked)) {
+		return []Entry{}, nil
+	}
+	return ranked[offset:min(offset+limit, int64(len(ranked)))], nil
+}
+
+func (s *MemoryStore) Rank(_ context.Context, key string, member string) (Entry, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, entry := range s.ranked(key) {
+		if entry.Member == member {
+			return entry, true, nil
+		}
+	}
+	return Entry{}, false, nil
+}
+
+func (s *MemoryStore) Count(_ context.Context, key string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	board := s.board(key)
+	if board == nil {
+		return 0, nil
+	}
+	return int64(len(board.scores)), nil
+}
+
+func (s *MemoryStore) Remove(_ context.Context, key string, member string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if board := s.board(key); board != nil {
+		delete(board.scores, member)
+	}
+	return nil
+}
+
+func (s *MemoryStore) Keys(_ context.Context, prefix string) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	keys := []string{}
+	for key := range s.boards {
+		if strings.HasPrefix(key, prefix) && s.board(key) != nil {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+func (s *MemoryStore) Tag(_ context.Context, key string, member string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.tags[key][member], nil
+}
+
+func (s *MemoryStore) SetTag(_ context.Context, key string, member string, tag string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if tag == "" {
+		delete(s.tags[key], member)
+		return nil
+	}
+	if s.tags[key] == nil {
+		s.tags[key] = map[string]string{}
+	}
+	s.tags[key][member] = tag
+	return nil
+}
+
+func (s *MemoryStore) ReplaceTags(_ context.Context, key string, tags map[string]string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	replaced := make(map[string]string, len(tags))
+	for member, tag := range tags {
+		if tag != "" {
+			replaced[member] = tag
+		}
+	}
+	s.tags[key] = replaced
+	return nil
+}
+
+// board returns the board at key, nil when there is none or it expired.
+// s.mu must be held.
+func (s *MemoryStore) board(key string) *memoryBoard {
+	board, ok := s.boards[key]
+	if !ok {
+		return nil
+	}
+	if !board.expireAt.IsZero() && !time.Now().Before(board.expireAt) {
+		delete(s.boards, key)
+		return nil
+	}
+	return board
+}
+
+// ranked returns every entry of the board at key in rank order.
+// s.mu must be held.
+func (s *MemoryStore) ranked(key string) []Entry {
+	board := s.board(key)
+	if board == nil {
+		return nil
+	}
+
+	entries := make([]Entry, 0, len(board.scores))
+	for member, score := range board.scores {
+		entries = append(entries, Entry{Member: member, Score: score})
+	}
+	slices.SortFunc(entries, func(a, b Entry) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.Member, a.Member)
+	})
+	for i := range entries {
+		entries[i].Rank = int64(i) + 1
+	}
+	return entries
+}
@@ -0,0 +1,136 @@
+package leaderboard
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestMemoryStoreRange(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	scores := map[string]float64{"ana": 30, "ben": 50, "cai": 30, "dee": 10}
+	if err := store.Replace(ctx, "board", scores, time.Time{}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name          string
+		key           string
+		offset, limit int64
+		want          []Entry
+	}{
+		{
+			name: "whole board, ties by member descending",
+			key:  "board", offset: 0, limit: 10,
+			want: []Entry{{"ben", 50, 1}, {"cai", 30, 2}, {"ana", 30, 3}, {"dee", 10, 4}},
+		},
+		{
+			name: "page",
+			key:  "board", offset: 1, limit: 2,
+			want: []Entry{{"cai", 30, 2}, {"ana", 30, 3}},
+		},
+		{
+			name: "past the end",
+			key:  "board", offset: 4, limit: 10,
+			want: []Entry{},
+		},
+		{
+			name: "missing board",
+			key:  "other", offset: 0, limit: 10,
+			want: []Entry{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := store.Range(ctx, tt.key, tt.offset, tt.limit)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Range(%d, %d) = %v, want %v", tt.offset, tt.limit, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMemoryStoreRankAndCount(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	store.Replace(ctx, "board", map[string]float64{"ana": 30, "ben": 50}, time.Time{})
+	store.Set(ctx, "board", "cai", 40, time.Time{})
+	store.Set(ctx, "board", "ana", 60, time.Time{})
+	store.Remove(ctx, "board", "ben")
+
+	tests := []struct {
+		member string
+		want   Entry
+		wantOK bool
+	}{
+		{"ana", Entry{"ana", 60, 1}, true},
+		{"cai", Entry{"cai", 40, 2}, true},
+		{"ben", Entry{}, false},
+	}
+
+	for _, tt := range tests {
+		got, ok, err := store.Rank(ctx, "board", tt.member)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("Rank(%s) = %v, %v, want %v, %v", tt.member, got, ok, tt.want, tt.wantOK)
+		}
+	}
+
+	if count, _ := store.Count(ctx, "board"); count != 2 {
+		t.Errorf("Count() = %d, want 2", count)
+	}
+}
+
+func TestMemoryStoreExpiry(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	store.Replace(ctx, "expired", map[string]float64{"ana": 1}, time.Now().Add(-time.Second))
+	store.Replace(ctx, "current", map[string]float64{"ana": 1}, time.Now().Add(time.Hour))
+
+	tests := []struct {
+		key  string
+		want int64
+	}{
+		{"expired", 0},
+		{"current", 1},
+	}
+
+	for _, tt := range tests {
+		if got, _ := store.Count(ctx, tt.key); got != tt.want {
+			t.Errorf("Count(%s) = %d, want %d", tt.key, got, tt.want)
+		}
+	}
+}
+
+func TestMemoryStoreTags(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	store.ReplaceTags(ctx, "tags", map[string]string{"ana": "Europe", "ben": "", "cai": "Asia"})
+	store.SetTag(ctx, "tags", "dee", "Africa")
+	store.SetTag(ctx, "tags", "cai", "")
+
+	tests := []struct {
+		member string
+		want   string
+	}{
+		{"ana", "Europe"},
+		{"ben", ""},
+		{"cai", ""},
+		{"dee", "Africa"},
+		{"eve", ""},
+	}
+
+	for _, tt := range tests {
+		if got, _ := store.Tag(ctx, "tags", tt.member); got != tt.want {
+			t.Errorf("Tag(%s) = %q, want %q", tt.member, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package leaderboard
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisStore keeps boards in Redis sorted sets
+type RedisStore struct {
+	client *redis.Client
+}
+
+// NewRedisStore returns a store keeping boards through client
+func NewRedisStore(client *redis.Client) *RedisStore {
+	return &RedisStore{client: client}
+}
+
+func (s *RedisStore) Replace(ctx context.Context, key string, scores map[string]float64, expireAt time.Time) error {
+	if len(scores) == 0 {
+		return s.client.Del(ctx, key).Err()
+	}
+
+	members := make([]redis.Z, 0, len(scores))
+	for member, score := range scores {
+		members = append(members, redis.Z{Score: score, Member: member})
+	}
+
+	// Build the new board aside and rename it over the old one, so readers
+	// never see a half-built board
+	tmp := key + ":rebuild"
+	_, err := s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, tmp)
+		pipe.ZAdd(ctx, tmp, members...)
+		pipe.Rename(ctx, tmp, key)
+		if !expireAt.IsZero() {
+			pipe.ExpireAt(ctx, key, expireAt)
+		}
+		return nil
+	})
+	return err
+}
+
+func (s *RedisStore) Set(ctx context.Context, key string, member string, score float64, expireAt time.Time) error {
+	_, err := s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZAdd(ctx, key, redis.Z{Score: score, Member: member})
+		if !expireAt.IsZero() {
+			pipe.ExpireAt(ctx, key, expireAt)
+		}
+		return nil
+	})
+	return err
+}
+
+func (s *RedisStore) Range(ctx context.Context, key string, offset, limit int64) ([]Entry, error) {
+	members, err := s.client.ZRevRangeWithScores(ctx, key, offset, offset+limit-1).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]Entry, 0, len(members))
+	for i, z := range members {
+		member, _ := z.Member.(string)
+		entries = append(entries, Entry{
+			Member: member,
+			Score:  z.Score,
+			Rank:   offset + int64(i) + 1,
+		})
+	}
+	return entries, nil
+}
+
+func (s *RedisStore) Rank(ctx context.Context, key string, member string) (Entry, bool, error) {
+	rank, err := s.client.ZRevRank(ctx, key, member).Result()
+	if errors.Is(err, redis.Nil) {
+		return Entry{}, false, nil
+	}
+	if err != nil {
+		return Entry{}, false, err
+	}
+
+	score, err := s.client.ZScore(ctx, key, member).Result()
+	if errors.Is(err, redis.Nil) {
+		return Entry{}, false, nil // removed in between
+	}
+	if err != nil {
+		return Entry{}, false, err
+	}
+
+	return Entry{Member: member, Score: score, Rank: rank + 1}, true, nil
+}
+
+func (s *RedisStore) Count(ctx context.Context, key string) (int64, error) {
+	return s.client.ZCard(ctx, key).Result()
+}
+
+func (s *RedisStore) Remove(ctx context.Context, key string, member string) error {
+	return s.client.ZRem(ctx, key, member).Err()
+}
+
+func (s *RedisStore) Keys(ctx context.Context, prefix string) ([]string, error) {
+	keys := []string{}
+	iter := s.client.ScanType(ctx, 0, prefix+"*", 100, "zset").Iterator()
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+	}
+	return keys, iter.Err()
+}
+
+func (s *RedisStore) Tag(ctx context.Context, key string, member string) (string, error) {
+	tag, err := s.client.HGet(ctx, key, member).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", nil
+	}
+	return tag, err
+}
+
+func (s *RedisStore) SetTag(ctx context.Context, key string, member string, tag string) error {
+	if tag == "" {
+		return s.client.HDel(ctx, key, member).Err()
+	}
+	return s.client.HSet(ctx, key, member, tag).Err()
+}
+
+func (s *RedisStore) ReplaceTags(ctx context.Context, key string, tags map[string]string) error {
+	values := make(map[string]any, len(tags))
+	for member, tag := range tags {
+		if tag != "" {
+			values[member] = tag
+		}
+	}
+
+	_, err := s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, key)
+		if len(values) > 0 {
+			pipe.HSet(ctx, key, values)
+		}
+		return nil
+	})
+	return err
+}
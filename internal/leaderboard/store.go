@@ -0,0 +1,42 @@
+package leaderboard
+
+import (
+	"context"
+	"time"
+)
+
+// Entry is a member's place on a board
+type Entry struct {
+	Member string
+	Score  float64
+	Rank   int64 // 1 is the top
+}
+
+// Store keeps boards of scored members, ranked from the highest score down.
+// Members with the same score are ranked by member, descending, like Redis
+// sorted sets do.
+type Store interface {
+	// Replace swaps the whole board for scores at once, no scores drop it. A
+	// non-zero expireAt drops the board at that time.
+	Replace(ctx context.Context, key string, scores map[string]float64, expireAt time.Time) error
+	// Set sets the score of one member
+	Set(ctx context.Context, key string, member string, score float64, expireAt time.Time) error
+	// Range returns up to limit entries starting at offset, from the top
+	Range(ctx context.Context, key string, offset, limit int64) ([]Entry, error)
+	// Rank returns the entry of a member, ok is false when it is not on the board
+	Rank(ctx context.Context, key string, member string) (entry Entry, ok bool, err error)
+	// Count returns how many members are on the board
+	Count(ctx context.Context, key string) (int64, error)
+	// Remove takes a member off the board
+	Remove(ctx context.Context, key string, member string) error
+	// Keys returns the keys of every board starting with prefix
+	Keys(ctx context.Context, prefix string) ([]string, error)
+
+	// Tag returns the tag of a member under key, empty when it has none.
+	// Tags are plain strings kept apart from boards.
+	Tag(ctx context.Context, key string, member string) (string, error)
+	// SetTag sets the tag of a member, an empty tag removes it
+	SetTag(ctx context.Context, key string, member string, tag string) error
+	// ReplaceTags swaps every tag under key for tags at once
+	ReplaceTags(ctx context.Context, key string, tags map[string]string) error
+}
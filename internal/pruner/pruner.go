@@ -0,0 +1,52 @@
+// Package pruner deletes reported player locations once they are older than
+// the retention period.
+package pruner
+
+import (
+	"context"
+	"log"
+	"time"
+
+	db "github.com/0xdbb/eggsplore/internal/database/sqlc"
+	"github.com/0xdbb/eggsplore/util"
+)
+
+// Pruner deletes old player locations
+type Pruner struct {
+	db        *db.Service
+	retention time.Duration
+}
+
+// New returns a pruner keeping locations for retention
+func New(service *db.Service, retention time.Duration) *Pruner {
+	return &Pruner{db: service, retention: retention}
+}
+
+// Run prunes every interval until ctx is done. Failed runs are logged and
+// retried on the next one.
+func (p *Pruner) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		deleted, err := p.Prune(ctx, util.Now())
+		switch {
+		case err != nil && ctx.Err() == nil:
+			log.Printf("⚠️ Location pruning failed: %v", err)
+		case deleted > 0:
+			log.Printf("Pruner: %d locations deleted", deleted)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Prune deletes the locations recorded more than the retention period
+// before now and returns how many there were.
+func (p *Pruner) Prune(ctx context.Context, now time.Time) (int64, error) {
+	return p.db.DeletePlayerLocationsBefore(ctx, now.Add(-p.retention))
+}
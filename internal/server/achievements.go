@@ -0,0 +1,148 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	db "github.com/0xdbb/eggsplore/internal/database/sqlc"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Machine-readable codes of rejected claims
+const (
+	AchievementNotFound       = "ACHIEVEMENT_NOT_FOUND"
+	AchievementNotUnlocked    = "ACHIEVEMENT_NOT_UNLOCKED"
+	AchievementAlreadyClaimed = "ACHIEVEMENT_ALREADY_CLAIMED"
+)
+
+// AchievementResponse represents an achievement with the caller's progress
+type AchievementResponse struct {
+	Code        string     `json:"code" example:"HATCH_10"`
+	Name        string     `json:"name" example:"Hatchery"`
+	Description string     `json:"description" example:"Hatch 10 eggs."`
+	Event       string     `json:"event" example:"EGG_HATCHED"`
+	EggType     string     `json:"egg_type,omitempty"`
+	Target      int64      `json:"target" example:"10"`
+	Progress    int64      `json:"progress" example:"4"` // capped at the target
+	RewardCoins int64      `json:"reward_coins" example:"100"`
+	RewardXp    int64      `json:"reward_xp" example:"100"`
+	Unlocked    bool       `json:"unlocked"`
+	UnlockedAt  *time.Time `json:"unlocked_at,omitempty"`
+	Claimed     bool       `json:"claimed"`
+	ClaimedAt   *time.Time `json:"claimed_at,omitempty"`
+}
+
+// ClaimAchievementResponse represents a paid achievement reward
+type ClaimAchievementResponse struct {
+	Achievement AchievementResponse `json:"achievement"`
+	Coins       int64               `json:"coins"` // player's balance after the reward
+	Xp          int64               `json:"xp"`
+	Level       int32               `json:"level"`
+	LevelUps    []LevelUpResponse   `json:"level_ups"`
+}
+
+// @Summary		List Achievements
+// @Description	List every achievement with the caller's progress, unlock and claim times
+// @Tags		game
+// @Produce		json
+// @Security	BearerAuth
+// @Success		200		{array}		AchievementResponse
+// @Failure		401		{object}	ErrorResponse
+// @Failure		500		{object}	ErrorResponse
+// @Router		/game/achievements [get]
+// @Router		/game/me/achievements [get]
+func (s *Server) ListAchievements(ctx *gin.Context) {
+	player, ok := s.currentPlayer(ctx)
+	if !ok {
+		return
+	}
+
+	rows, err := s.db.ListPlayerAchievements(ctx, player.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch achievements"))
+		return
+	}
+
+	rsp := make([]AchievementResponse, 0, len(rows))
+	for _, r := range rows {
+		rsp = append(rsp, AchievementResponse{
+			Code:        r.Code,
+			Name:        r.Name,
+			Description: r.Description,
+			Event:       r.Event,
+			EggType:     r.EggType.String,
+			Target:      r.Target,
+			Progress:    min(r.Progress, r.Target),
+			RewardCoins: r.RewardCoins,
+			RewardXp:    r.RewardXp,
+			Unlocked:    r.UnlockedAt.Valid,
+			UnlockedAt:  timestamptzToTime(r.UnlockedAt),
+			Claimed:     r.ClaimedAt.Valid,
+			ClaimedAt:   timestamptzToTime(r.ClaimedAt),
+		})
+	}
+
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+// @Summary		Claim Achievement
+// @Description	Claim the coin and XP reward of an unlocked achievement. Each reward can be claimed once.
+// @Description	Rejections carry an error_code: ACHIEVEMENT_NOT_FOUND, ACHIEVEMENT_NOT_UNLOCKED or ACHIEVEMENT_ALREADY_CLAIMED.
+// @Tags		game
+// @Produce		json
+// @Security	BearerAuth
+// @Param		code	path		string	true	"Achievement code"
+// @Success		200		{object}	ClaimAchievementResponse
+// @Failure		401		{object}	ErrorResponse
+// @Failure		403		{object}	ErrorResponse
+// @Failure		404		{object}	ErrorResponse
+// @Failure		409		{object}	ErrorResponse
+// @Failure		500		{object}	ErrorResponse
+// @Router		/game/achievements/{code}/claim [post]
+// @Router		/game/me/achievements/{code}/claim [post]
+func (s *Server) ClaimAchievement(ctx *gin.Context) {
+	player, ok := s.currentPlayer(ctx)
+	if !ok {
+		return
+	}
+
+	result, err := s.db.ClaimAchievementTx(ctx, player.ID, ctx.Param("code"))
+	if err != nil {
+		switch {
+		case errors.Is(err, db.ErrRecordNotFound):
+			ctx.JSON(http.StatusNotFound, HandleCodedError(AchievementNotFound, http.StatusNotFound, "Achievement not found"))
+		case errors.Is(err, db.ErrNotUnlocked):
+			ctx.JSON(http.StatusForbidden, HandleCodedError(AchievementNotUnlocked, http.StatusForbidden, "Achievement is not unlocked yet"))
+		case errors.Is(err, db.ErrAlreadyClaimed):
+			ctx.JSON(http.StatusConflict, HandleCodedError(AchievementAlreadyClaimed, http.StatusConflict, "Achievement reward was already claimed"))
+		default:
+			ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to claim achievement"))
+		}
+		return
+	}
+
+	a, progress := result.Achievement, result.Progress
+	ctx.JSON(http.StatusOK, ClaimAchievementResponse{
+		Achievement: AchievementResponse{
+			Code:        a.Code,
+			Name:        a.Name,
+			Description: a.Description,
+			Event:       a.Event,
+			EggType:     a.EggType.String,
+			Target:      a.Target,
+			Progress:    min(progress.Progress, a.Target),
+			RewardCoins: a.RewardCoins,
+			RewardXp:    a.RewardXp,
+			Unlocked:    true,
+			UnlockedAt:  timestamptzToTime(progress.UnlockedAt),
+			Claimed:     true,
+			ClaimedAt:   timestamptzToTime(progress.ClaimedAt),
+		},
+		Coins:    result.Player.Coins,
+		Xp:       result.Player.Xp,
+		Level:    result.Player.Level,
+		LevelUps: levelUpResponses(result.LevelUps),
+	})
+}
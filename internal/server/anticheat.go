@@ -0,0 +1,307 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	db "github.com/0xdbb/eggsplore/internal/database/sqlc"
+	"github.com/0xdbb/eggsplore/internal/game"
+	"github.com/0xdbb/eggsplore/util"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	trailWindow = 30 * time.Minute // how far back reported locations are compared
+	trailFixes  = 20
+
+	defaultIncidentLimit = 50
+	maxIncidentLimit     = 200
+)
+
+// Error codes of locations the anti-cheat stopped
+const (
+	LocationRejected = "LOCATION_REJECTED"
+	LocationOnHold   = "LOCATION_ON_HOLD"
+)
+
+// Where an evaluated location was reported
+const (
+	sourceHeartbeat = "HEARTBEAT"
+	sourceDrop      = "DROP"
+)
+
+// screenLocation evaluates a location the player reported against their
+// trail and records an incident when it looks faked. It writes the response
+// and returns false when the location is rejected.
+func (s *Server) screenLocation(ctx *gin.Context, playerID uuid.UUID, fix game.Fix, source string) (game.Verdict, bool) {
+	rows, err := s.db.ListPlayerTrail(ctx, db.ListPlayerTrailParams{
+		Lon:      fix.Lon,
+		Lat:      fix.Lat,
+		PlayerID: playerID,
+		Since:    fix.At.Add(-trailWindow),
+		MaxFixes: trailFixes,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch location trail"))
+		return game.Verdict{}, false
+	}
+	trail := make([]game.TrailFix, 0, len(rows))
+	for _, r := range rows {
+		trail = append(trail, game.TrailFix{
+			Fix:      game.Fix{Lat: r.Lat, Lon: r.Lon, Accuracy: r.AccuracyMeters, At: r.RecordedAt},
+			Distance: r.Distance,
+		})
+	}
+
+	verdict := s.config.AntiCheatRules().Evaluate(fix, trail)
+	if verdict.Flagged() {
+		s.recordIncident(ctx, playerID, fix, source, verdict)
+	}
+
+	if verdict.Action == game.ActionReject {
+		ctx.JSON(http.StatusForbidden, HandleCodedError(LocationRejected, http.StatusForbidden, "Location rejected"))
+		return verdict, false
+	}
+	return verdict, true
+}
+
+// recordIncident stores a flagged location for review. Failing to store it
+// only gets logged, the verdict stands either way.
+func (s *Server) recordIncident(ctx *gin.Context, playerID uuid.UUID, fix game.Fix, source string, verdict game.Verdict) {
+	signals, err := json.Marshal(verdict.Signals)
+	if err != nil {
+		log.Printf("⚠️ Failed to encode location signals for player %s: %v", playerID, err)
+		return
+	}
+
+	arg := db.CreateLocationIncidentParams{
+		PlayerID: playerID,
+		Source:   source,
+		Lon:      fix.Lon,
+		Lat:      fix.Lat,
+		Score:    int32(verdict.Score),
+		Action:   verdict.Action,
+		Signals:  signals,
+	}
+	if fix.Accuracy >= 0 {
+		arg.AccuracyMeters = pgtype.Float8{Float64: fix.Accuracy, Valid: true}
+	}
+	if verdict.Action == game.ActionDelay {
+		arg.HoldUntil = pgtype.Timestamptz{Time: fix.At.Add(verdict.Hold), Valid: true}
+	}
+
+	if _, err := s.db.CreateLocationIncident(ctx, arg); err != nil {
+		log.Printf("⚠️ Failed to record location incident for player %s: %v", playerID, err)
+	}
+}
+
+// checkLocationHold writes the response and returns false while the
+// player's location based actions are on hold after a delayed location.
+func (s *Server) checkLocationHold(ctx *gin.Context, playerID uuid.UUID) bool {
+	holdUntil, err := s.db.GetLocationHold(ctx, playerID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to check location hold"))
+		return false
+	}
+
+	wait := holdUntil.Sub(util.Now())
+	if wait <= 0 {
+		return true
+	}
+	ctx.Header("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+	msg := fmt.Sprintf("Location under review, retry in %s", wait.Round(time.Second))
+	ctx.JSON(http.StatusTooManyRequests, HandleCodedError(LocationOnHold, http.StatusTooManyRequests, msg))
+	return false
+}
+
+// LocationIncidentsRequest represents a page of location incidents
+type LocationIncidentsRequest struct {
+	PlayerID string `form:"player_id"`
+	Reviewed *bool  `form:"reviewed"`
+	Limit    int32  `form:"limit" binding:"omitempty,gte=1"`
+	Offset   int32  `form:"offset" binding:"omitempty,gte=0"`
+}
+
+// LocationIncidentResponse represents a reported location the anti-cheat flagged
+type LocationIncidentResponse struct {
+	ID         int64         `json:"id"`
+	PlayerID   string        `json:"player_id"`
+	Username   string        `json:"username"`
+	Source     string        `json:"source" example:"HEARTBEAT"`
+	Lat        float64       `json:"lat"`
+	Lon        float64       `json:"lon"`
+	Accuracy   *float64      `json:"accuracy,omitempty"` // meters, unset when the client did not report it
+	Score      int32         `json:"score" example:"50"`
+	Action     string        `json:"action" example:"DELAY"`
+	Signals    []game.Signal `json:"signals"`
+	HoldUntil  *time.Time    `json:"hold_until,omitempty"`
+	CreatedAt  time.Time     `json:"created_at"`
+	Review     string        `json:"review,omitempty" example:"CONFIRMED"`
+	ReviewNote string        `json:"review_note,omitempty"`
+	ReviewedBy string        `json:"reviewed_by,omitempty"`
+	ReviewedAt *time.Time    `json:"reviewed_at,omitempty"`
+}
+
+// ReviewLocationIncidentRequest represents a reviewer's decision on an incident
+type ReviewLocationIncidentRequest struct {
+	Review string `json:"review" binding:"required,oneof=CONFIRMED DISMISSED" example:"DISMISSED"`
+	Note   string `json:"note"`
+}
+
+// @Summary		List Location Incidents
+// @Description	List reported locations the anti-cheat flagged, newest first, with the signals raised and what happened to the action
+// @Tags		admin
+// @Produce		json
+// @Security	BearerAuth
+// @Param		player_id	query		string	false	"Only incidents of this player"
+// @Param		reviewed	query		bool	false	"Only reviewed (true) or open (false) incidents"
+// @Param		limit		query		int		false	"Page size (default 50, max 200)"
+// @Param		offset		query		int		false	"Page offset"
+// @Success		200			{array}		LocationIncidentResponse
+// @Failure		400			{object}	ErrorResponse
+// @Failure		401			{object}	ErrorResponse
+// @Failure		403			{object}	ErrorResponse
+// @Failure		500			{object}	ErrorResponse
+// @Router		/admin/incidents [get]
+func (s *Server) ListLocationIncidents(ctx *gin.Context) {
+	var req LocationIncidentsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		if valErr := HandleValidationError(err); valErr != nil {
+			ctx.JSON(http.StatusBadRequest, valErr)
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, HandleError(nil, http.StatusBadRequest, "Invalid request format"))
+		return
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultIncidentLimit
+	}
+	limit = min(limit, maxIncidentLimit)
+
+	arg := db.ListLocationIncidentsParams{
+		PageLimit:  limit,
+		PageOffset: req.Offset,
+	}
+	if req.PlayerID != "" {
+		playerID, ok := parseUUID(ctx, req.PlayerID, "player id")
+		if !ok {
+			return
+		}
+		arg.PlayerID = pgtype.UUID{Bytes: playerID, Valid: true}
+	}
+	if req.Reviewed != nil {
+		arg.Reviewed = pgtype.Bool{Bool: *req.Reviewed, Valid: true}
+	}
+
+	incidents, err := s.db.ListLocationIncidents(ctx, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch incidents"))
+		return
+	}
+
+	rsp := make([]LocationIncidentResponse, 0, len(incidents))
+	for _, i := range incidents {
+		rsp = append(rsp, locationIncidentResponse(i))
+	}
+
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+// @Summary		Review Location Incident
+// @Description	Confirm or dismiss a flagged location. Dismissing lifts the hold it put on the player's actions.
+// @Tags		admin
+// @Accept		json
+// @Produce		json
+// @Security	BearerAuth
+// @Param		id		path		int								true	"Incident ID"
+// @Param		request	body		ReviewLocationIncidentRequest	true	"Review"
+// @Success		200		{object}	UserMessage
+// @Failure		400		{object}	ErrorResponse
+// @Failure		401		{object}	ErrorResponse
+// @Failure		403		{object}	ErrorResponse
+// @Failure		404		{object}	ErrorResponse
+// @Failure		500		{object}	ErrorResponse
+// @Router		/admin/incidents/{id}/review [post]
+func (s *Server) ReviewLocationIncident(ctx *gin.Context) {
+	var req ReviewLocationIncidentRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		if valErr := HandleValidationError(err); valErr != nil {
+			ctx.JSON(http.StatusBadRequest, valErr)
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, HandleError(nil, http.StatusBadRequest, "Invalid request format"))
+		return
+	}
+
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, HandleError(nil, http.StatusBadRequest, "Invalid incident id format"))
+		return
+	}
+
+	payload, ok := authPayload(ctx)
+	if !ok {
+		return
+	}
+
+	reviewed, err := s.db.ReviewLocationIncident(ctx, db.ReviewLocationIncidentParams{
+		Review:     req.Review,
+		ReviewNote: req.Note,
+		ReviewedBy: payload.AccountID,
+		ReviewedAt: util.Now(),
+		ID:         id,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to review incident"))
+		return
+	}
+	if reviewed == 0 {
+		ctx.JSON(http.StatusNotFound, HandleError(nil, http.StatusNotFound, "Incident not found"))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, HandleMessage("Incident reviewed"))
+}
+
+func locationIncidentResponse(i db.ListLocationIncidentsRow) LocationIncidentResponse {
+	rsp := LocationIncidentResponse{
+		ID:         i.ID,
+		PlayerID:   i.PlayerID.String(),
+		Username:   i.Username.String,
+		Source:     i.Source,
+		Lat:        i.Lat,
+		Lon:        i.Lon,
+		Score:      i.Score,
+		Action:     i.Action,
+		Signals:    []game.Signal{},
+		CreatedAt:  i.CreatedAt,
+		Review:     i.Review.String,
+		ReviewNote: i.ReviewNote.String,
+	}
+	if err := json.Unmarshal(i.Signals, &rsp.Signals); err != nil {
+		log.Printf("⚠️ Invalid signals on location incident %d: %v", i.ID, err)
+	}
+	if i.AccuracyMeters.Valid {
+		rsp.Accuracy = &i.AccuracyMeters.Float64
+	}
+	if i.HoldUntil.Valid {
+		rsp.HoldUntil = &i.HoldUntil.Time
+	}
+	if i.ReviewedBy.Valid {
+		rsp.ReviewedBy = uuid.UUID(i.ReviewedBy.Bytes).String()
+	}
+	if i.ReviewedAt.Valid {
+		rsp.ReviewedAt = &i.ReviewedAt.Time
+	}
+	return rsp
+}
@@ -131,7 +131,7 @@ type RegisterAccountRequest struct {
 }
 
 // @Summary		Register Account
-// @Description	Register account with email and password
+// @Description	Register account with email and password and provision its player with the starter kit
 // @Tags		auth
 // @Accept		json
 // @Produce		json
@@ -170,15 +170,19 @@ func (s *Server) Register(ctx *gin.Context) {
 		return
 	}
 
-	arg := db.CreateAccountParams{
-		Email:     req.Email,
-		Password:  hashPassword,
-		FirstName: stringToPgtype(req.FirstName),
-		LastName:  stringToPgtype(req.LastName),
-		Username:  stringToPgtype(req.UserName),
+	arg := db.CreateAccountTxParams{
+		CreateAccountParams: db.CreateAccountParams{
+			Email:     req.Email,
+			Password:  hashPassword,
+			FirstName: stringToPgtype(req.FirstName),
+			LastName:  stringToPgtype(req.LastName),
+			Username:  stringToPgtype(req.UserName),
+		},
+		StarterKit: s.starterKit(),
 	}
 
-	_, err = s.db.CreateAccount(ctx, arg)
+	// Account and player are created together so every account can play
+	_, err = s.db.CreateAccountTx(ctx, arg)
 	if err != nil {
 		if errors.Is(err, db.ErrUniqueViolation) {
 			ctx.JSON(http.StatusNotFound, HandleError(nil, http.StatusNotFound, "User with this email or username already exists"))
@@ -193,6 +197,14 @@ func (s *Server) Register(ctx *gin.Context) {
 	})
 }
 
+// starterKit returns what every new player starts with
+func (s *Server) starterKit() db.StarterKit {
+	return db.StarterKit{
+		Coins: s.config.StartingCoins,
+		Tools: s.config.StarterTools,
+	}
+}
+
 // @Summary		Logout Account
 // @Description	Logout account by deleting session and clearing cookies
 // @Tags		auth
@@ -304,6 +316,13 @@ func pgtypeToString(p pgtype.Text) string {
 	return ""
 }
 
+func timestamptzToTime(t pgtype.Timestamptz) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
 func stringToPgtype(s string) pgtype.Text {
 	if s == "" {
 		return pgtype.Text{Valid: false}
@@ -0,0 +1,167 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	db "github.com/0xdbb/eggsplore/internal/database/sqlc"
+	"github.com/0xdbb/eggsplore/internal/game"
+	"github.com/0xdbb/eggsplore/util"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// BoostItemResponse represents a stack of boosts in a player's inventory
+type BoostItemResponse struct {
+	InventoryID     string  `json:"inventory_id"`
+	Kind            string  `json:"kind" example:"XP_MULTIPLIER"`
+	Multiplier      float64 `json:"multiplier" example:"2"`
+	DurationSeconds int32   `json:"duration_seconds" example:"1800"`
+	Quantity        int32   `json:"quantity"`
+	Description     string  `json:"description"`
+}
+
+// ActiveBoostResponse represents an activated boost
+type ActiveBoostResponse struct {
+	Kind        string    `json:"kind" example:"XP_MULTIPLIER"`
+	Multiplier  float64   `json:"multiplier" example:"2"`
+	ActivatedAt time.Time `json:"activated_at"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	TimeLeft    int64     `json:"time_left_seconds" example:"1800"`
+}
+
+// PlayerBoostsResponse lists a player's boosts
+type PlayerBoostsResponse struct {
+	Owned  []BoostItemResponse   `json:"owned"`
+	Active []ActiveBoostResponse `json:"active"`
+}
+
+// ActivateBoostResponse represents a boost that was just activated
+type ActivateBoostResponse struct {
+	Active    ActiveBoostResponse `json:"active"`
+	Remaining int32               `json:"remaining"` // boosts left in the stack
+}
+
+// @Summary		Get Player Boosts
+// @Description	Get the boosts a player owns and the ones currently active
+// @Tags		game
+// @Produce		json
+// @Security	BearerAuth
+// @Param		player_id	query		string	false	"Player ID (defaults to the caller, must be the caller's)"
+// @Success		200		{object}	PlayerBoostsResponse
+// @Failure		400		{object}	ErrorResponse
+// @Failure		401		{object}	ErrorResponse
+// @Failure		403		{object}	ErrorResponse
+// @Failure		500		{object}	ErrorResponse
+// @Router		/game/boosts [get]
+// @Router		/game/me/boosts [get]
+func (s *Server) GetPlayerBoosts(ctx *gin.Context) {
+	player, ok := s.requestPlayer(ctx, ctx.Query("player_id"))
+	if !ok {
+		return
+	}
+
+	owned, err := s.db.GetBoostsByPlayer(ctx, player.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch boosts"))
+		return
+	}
+
+	active, err := s.db.ListActiveBoosts(ctx, player.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch active boosts"))
+		return
+	}
+
+	now := util.Now()
+	rsp := PlayerBoostsResponse{
+		Owned:  make([]BoostItemResponse, 0, len(owned)),
+		Active: make([]ActiveBoostResponse, 0, len(active)),
+	}
+	for _, b := range owned {
+		rsp.Owned = append(rsp.Owned, BoostItemResponse{
+			InventoryID:     b.InventoryID.String(),
+			Kind:            b.Kind,
+			Multiplier:      b.Multiplier,
+			DurationSeconds: b.DurationSeconds,
+			Quantity:        b.Quantity,
+			Description:     pgtypeToString(b.Description),
+		})
+	}
+	for _, b := range active {
+		rsp.Active = append(rsp.Active, activeBoostResponse(b, now))
+	}
+
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+// @Summary		Activate Boost
+// @Description	Use one boost from a stack in the caller's inventory. It applies to the caller's game actions until it expires. Active boosts of the same kind stack.
+// @Tags		game
+// @Produce		json
+// @Security	BearerAuth
+// @Param		id	path		string	true	"Boost inventory ID"
+// @Success		200	{object}	ActivateBoostResponse
+// @Failure		400	{object}	ErrorResponse
+// @Failure		401	{object}	ErrorResponse
+// @Failure		404	{object}	ErrorResponse
+// @Failure		500	{object}	ErrorResponse
+// @Router		/game/boosts/{id}/activate [post]
+// @Router		/game/me/boosts/{id}/activate [post]
+func (s *Server) ActivateBoost(ctx *gin.Context) {
+	boostID, ok := parseUUID(ctx, ctx.Param("id"), "boost id")
+	if !ok {
+		return
+	}
+
+	player, ok := s.currentPlayer(ctx)
+	if !ok {
+		return
+	}
+
+	now := util.Now()
+	result, err := s.db.ActivateBoostTx(ctx, db.ActivateBoostTxParams{
+		PlayerID:    player.ID,
+		BoostID:     boostID,
+		ActivatedAt: now,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, HandleError(nil, http.StatusNotFound, "Boost not found"))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to activate boost"))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ActivateBoostResponse{
+		Active:    activeBoostResponse(result.Active, now),
+		Remaining: result.Remaining,
+	})
+}
+
+// activeBoosts combines the player's active boosts
+func (s *Server) activeBoosts(ctx *gin.Context, playerID uuid.UUID) (game.Boosts, error) {
+	active, err := s.db.ListActiveBoosts(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	boosts := game.Boosts{}
+	for _, b := range active {
+		boosts.Add(game.BoostKind(b.Kind), b.Multiplier)
+	}
+	return boosts, nil
+}
+
+func activeBoostResponse(b db.ActiveBoosts, now time.Time) ActiveBoostResponse {
+	return ActiveBoostResponse{
+		Kind:        b.Kind,
+		Multiplier:  b.Multiplier,
+		ActivatedAt: b.ActivatedAt,
+		ExpiresAt:   b.ExpiresAt,
+		TimeLeft:    int64(max(b.ExpiresAt.Sub(now), 0).Seconds()),
+	}
+}
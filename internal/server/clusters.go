@@ -0,0 +1,323 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	db "github.com/0xdbb/eggsplore/internal/database/sqlc"
+	"github.com/0xdbb/eggsplore/internal/game"
+	"github.com/0xdbb/eggsplore/util"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// maxViewportTiles caps the tiles a single clusters request may span
+const maxViewportTiles = 64
+
+// maxCachedTiles is how many tiles the cache holds before dropping expired ones
+const maxCachedTiles = 4096
+
+// EggClustersRequest represents a map viewport at a zoom level
+type EggClustersRequest struct {
+	XMin *float64 `form:"xmin" binding:"required,gte=-180,lte=180"`
+	YMin *float64 `form:"ymin" binding:"required,gte=-90,lte=90"`
+	XMax *float64 `form:"xmax" binding:"required,gte=-180,lte=180"`
+	YMax *float64 `form:"ymax" binding:"required,gte=-90,lte=90"`
+	Zoom *int     `form:"zoom" binding:"required,gte=0,lte=22"`
+}
+
+// EggClustersResponse represents the eggs in a viewport, tile by tile
+type EggClustersResponse struct {
+	Zoom  int               `json:"zoom" example:"12"`
+	Tiles []EggTileResponse `json:"tiles"`
+}
+
+// EggTileResponse represents the eggs in one map tile. Below the points zoom
+// they come as clusters, from it on one by one.
+type EggTileResponse struct {
+	Tile     util.Tile            `json:"tile"`
+	Bounds   util.BoundingBox     `json:"bounds"`
+	Clusters []EggClusterResponse `json:"clusters"`
+	Eggs     []EggPointResponse   `json:"eggs"`
+}
+
+// EggClusterResponse represents the eggs in one grid cell of a tile
+type EggClusterResponse struct {
+	Lat   float64        `json:"lat" example:"5.89980"` // mean position of the eggs' blur cells
+	Lon   float64        `json:"lon" example:"-2.03874"`
+	Count int            `json:"count" example:"12"`
+	Types map[string]int `json:"types"` // egg type to count
+}
+
+// EggPointResponse represents a single egg on the map. Other players' eggs
+// are placed at the center of their blur cell.
+type EggPointResponse struct {
+	InventoryID string  `json:"inventory_id"`
+	Type        string  `json:"type"`
+	Lat         float64 `json:"lat"`
+	Lon         float64 `json:"lon"`
+
+	owner uuid.UUID
+	exact util.Coord // shown to the owner only
+}
+
+// @Summary		Get Egg Clusters
+// @Description	Get the active eggs in a viewport for a map at a zoom level, split into map tiles (slippy map numbering). Below zoom 17 each tile holds clusters of eggs, counted per egg type; from zoom 17 on it lists the eggs one by one. Other players' eggs are counted and placed at the center of the roughly 550m grid cell they lie in; only the caller's own eggs are listed at their exact position. Tiles are cached for a short while, so eggs may show up or go away a little late.
+// @Tags		game
+// @Produce		json
+// @Security	BearerAuth
+// @Param		xmin	query		number	true	"Western longitude"
+// @Param		ymin	query		number	true	"Southern latitude"
+// @Param		xmax	query		number	true	"Eastern longitude"
+// @Param		ymax	query		number	true	"Northern latitude"
+// @Param		zoom	query		int		true	"Map zoom level, 0 to 22"
+// @Success		200		{object}	EggClustersResponse
+// @Failure		400		{object}	ErrorResponse
+// @Failure		401		{object}	ErrorResponse
+// @Failure		500		{object}	ErrorResponse
+// @Router		/game/eggs/clusters [get]
+func (s *Server) GetEggClusters(ctx *gin.Context) {
+	var req EggClustersRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		if valErr := HandleValidationError(err); valErr != nil {
+			ctx.JSON(http.StatusBadRequest, valErr)
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, HandleError(nil, http.StatusBadRequest, "Invalid request format"))
+		return
+	}
+
+	bbox := util.BoundingBox{XMin: *req.XMin, YMin: *req.YMin, XMax: *req.XMax, YMax: *req.YMax}
+	if bbox.XMin >= bbox.XMax || bbox.YMin >= bbox.YMax {
+		ctx.JSON(http.StatusBadRequest, HandleError(nil, http.StatusBadRequest, "Invalid bounding box"))
+		return
+	}
+
+	player, ok := s.currentPlayer(ctx)
+	if !ok {
+		return
+	}
+
+	tiles, ok := util.TilesCovering(bbox, *req.Zoom, maxViewportTiles)
+	if !ok {
+		msg := fmt.Sprintf("Viewport spans more than %d tiles, zoom in or narrow it", maxViewportTiles)
+		ctx.JSON(http.StatusBadRequest, HandleError(nil, http.StatusBadRequest, msg))
+		return
+	}
+
+	rsp := EggClustersResponse{Zoom: *req.Zoom, Tiles: make([]EggTileResponse, 0, len(tiles))}
+	for _, tile := range tiles {
+		eggs, err := s.eggTile(ctx, tile)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch eggs"))
+			return
+		}
+		rsp.Tiles = append(rsp.Tiles, eggs.forPlayer(player.ID))
+	}
+
+	s.setTileCacheHeaders(ctx)
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+// @Summary		Get Egg Tile
+// @Description	Get the active eggs in one map tile (slippy map numbering): clusters counted per egg type below zoom 17, the eggs one by one from it on. Other players' eggs are placed at the center of the roughly 550m grid cell they lie in. Each tile has its own URL, so clients can cache tiles as they pan.
+// @Tags		game
+// @Produce		json
+// @Security	BearerAuth
+// @Param		z	path		int	true	"Zoom level, 0 to 22"
+// @Param		x	path		int	true	"Tile column"
+// @Param		y	path		int	true	"Tile row"
+// @Success		200	{object}	EggTileResponse
+// @Failure		400	{object}	ErrorResponse
+// @Failure		401	{object}	ErrorResponse
+// @Failure		500	{object}	ErrorResponse
+// @Router		/game/eggs/tiles/{z}/{x}/{y} [get]
+func (s *Server) GetEggTile(ctx *gin.Context) {
+	z, zErr := strconv.Atoi(ctx.Param("z"))
+	x, xErr := strconv.Atoi(ctx.Param("x"))
+	y, yErr := strconv.Atoi(ctx.Param("y"))
+	if zErr != nil || xErr != nil || yErr != nil {
+		ctx.JSON(http.StatusBadRequest, HandleError(nil, http.StatusBadRequest, "Invalid tile format"))
+		return
+	}
+
+	tile := util.Tile{Z: z, X: x, Y: y}
+	if tile.Z < game.MinMapZoom || tile.Z > game.MaxMapZoom || !tile.Valid() {
+		ctx.JSON(http.StatusBadRequest, HandleError(nil, http.StatusBadRequest, "Tile does not exist"))
+		return
+	}
+
+	player, ok := s.currentPlayer(ctx)
+	if !ok {
+		return
+	}
+
+	rsp, err := s.eggTile(ctx, tile)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch eggs"))
+		return
+	}
+
+	s.setTileCacheHeaders(ctx)
+	ctx.JSON(http.StatusOK, rsp.forPlayer(player.ID))
+}
+
+// setTileCacheHeaders lets the client keep tiles for as long as the server
+// does. Egg positions are only shown to signed in players, so shared caches
+// must not keep them.
+func (s *Server) setTileCacheHeaders(ctx *gin.Context) {
+	ttl := int(s.config.TileCacheTTL.Seconds())
+	if ttl <= 0 {
+		ctx.Header("Cache-Control", "no-store")
+		return
+	}
+	ctx.Header("Cache-Control", fmt.Sprintf("private, max-age=%d", ttl))
+}
+
+// eggTile returns the eggs in tile, from the cache when it is fresh. The
+// tile is shared by all players, so every egg in it is at its blur cell.
+func (s *Server) eggTile(ctx context.Context, tile util.Tile) (EggTileResponse, error) {
+	now := util.Now()
+	if rsp, ok := s.tiles.get(tile, now); ok {
+		return rsp, nil
+	}
+
+	bounds := tile.Bounds()
+	rsp := EggTileResponse{
+		Tile:     tile,
+		Bounds:   bounds,
+		Clusters: []EggClusterResponse{},
+		Eggs:     []EggPointResponse{},
+	}
+
+	if game.ShowPoints(tile.Z) {
+		eggs, err := s.db.ListEggPointsInBounds(ctx, db.ListEggPointsInBoundsParams{
+			BlurCell:  game.BlurCellDegrees,
+			Xmin:      bounds.XMin,
+			Ymin:      bounds.YMin,
+			Xmax:      bounds.XMax,
+			Ymax:      bounds.YMax,
+			MaxPoints: game.MaxTilePoints,
+		})
+		if err != nil {
+			return EggTileResponse{}, err
+		}
+		for _, egg := range eggs {
+			rsp.Eggs = append(rsp.Eggs, EggPointResponse{
+				InventoryID: egg.InventoryID.String(),
+				Type:        egg.Type,
+				Lat:         egg.CellLat,
+				Lon:         egg.CellLon,
+				owner:       egg.OwnerID,
+				exact:       util.Coord{Lat: egg.Lat, Lon: egg.Lon},
+			})
+		}
+	} else {
+		groups, err := s.db.ListEggClusters(ctx, db.ListEggClustersParams{
+			Xmin:       bounds.XMin,
+			Ymin:       bounds.YMin,
+			CellWidth:  (bounds.XMax - bounds.XMin) / game.ClusterCells,
+			CellHeight: (bounds.YMax - bounds.YMin) / game.ClusterCells,
+			BlurCell:   game.BlurCellDegrees,
+			Xmax:       bounds.XMax,
+			Ymax:       bounds.YMax,
+		})
+		if err != nil {
+			return EggTileResponse{}, err
+		}
+		rsp.Clusters = mergeClusters(groups)
+	}
+
+	s.tiles.put(tile, rsp, now)
+	return rsp, nil
+}
+
+// forPlayer returns the tile as playerID sees it, with their own eggs at
+// their exact position. The cached tile is left as it is.
+func (t EggTileResponse) forPlayer(playerID uuid.UUID) EggTileResponse {
+	eggs := make([]EggPointResponse, len(t.Eggs))
+	for i, egg := range t.Eggs {
+		if egg.owner == playerID {
+			egg.Lat, egg.Lon = egg.exact.Lat, egg.exact.Lon
+		}
+		eggs[i] = egg
+	}
+	t.Eggs = eggs
+	return t
+}
+
+// mergeClusters folds the per type groups of each grid cell into one
+// cluster, placed at the mean position of all its eggs. Groups of a cell
+// come one after another.
+func mergeClusters(groups []db.ListEggClustersRow) []EggClusterResponse {
+	clusters := []EggClusterResponse{}
+	for i, group := range groups {
+		if i == 0 || group.CellLon != groups[i-1].CellLon || group.CellLat != groups[i-1].CellLat {
+			clusters = append(clusters, EggClusterResponse{Types: map[string]int{}})
+		}
+
+		c := &clusters[len(clusters)-1]
+		n := int(group.Count)
+		c.Lat = (c.Lat*float64(c.Count) + group.Lat*float64(n)) / float64(c.Count+n)
+		c.Lon = (c.Lon*float64(c.Count) + group.Lon*float64(n)) / float64(c.Count+n)
+		c.Count += n
+		c.Types[group.Type] += n
+	}
+	return clusters
+}
+
+// tileCache keeps recently built egg tiles in memory, so panning players
+// asking for the same tiles do not rebuild them each time
+type tileCache struct {
+	ttl time.Duration
+
+	mu    sync.Mutex
+	tiles map[util.Tile]cachedTile
+}
+
+type cachedTile struct {
+	rsp     EggTileResponse
+	expires time.Time
+}
+
+func newTileCache(ttl time.Duration) *tileCache {
+	return &tileCache{ttl: ttl, tiles: map[util.Tile]cachedTile{}}
+}
+
+func (c *tileCache) get(tile util.Tile, now time.Time) (EggTileResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cached, ok := c.tiles[tile]
+	if !ok || !now.Before(cached.expires) {
+		return EggTileResponse{}, false
+	}
+	return cached.rsp, true
+}
+
+func (c *tileCache) put(tile util.Tile, rsp EggTileResponse, now time.Time) {
+	if c.ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.tiles) >= maxCachedTiles {
+		for key, cached := range c.tiles {
+			if !now.Before(cached.expires) {
+				delete(c.tiles, key)
+			}
+		}
+		// Everything is still fresh, start over rather than grow
+		if len(c.tiles) >= maxCachedTiles {
+			c.tiles = map[util.Tile]cachedTile{}
+		}
+	}
+	c.tiles[tile] = cachedTile{rsp: rsp, expires: now.Add(c.ttl)}
+}
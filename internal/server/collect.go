@@ -0,0 +1,161 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	db "github.com/0xdbb/eggsplore/internal/database/sqlc"
+	"github.com/0xdbb/eggsplore/internal/game"
+	"github.com/0xdbb/eggsplore/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CollectEggResponse represents an egg picked up from another player
+type CollectEggResponse struct {
+	InventoryID   string            `json:"inventory_id"`
+	Type          string            `json:"type"`
+	CollectedAt   time.Time         `json:"collected_at"`
+	DropperID     string            `json:"dropper_id"`
+	Reward        game.EggReward    `json:"reward"`         // what the caller earned
+	DropperReward game.EggReward    `json:"dropper_reward"` // what the dropper earned
+	Coins         int64             `json:"coins"`          // caller's balance after the reward
+	Xp            int64             `json:"xp"`
+	Level         int32             `json:"level"`
+	LevelUps      []LevelUpResponse `json:"level_ups"`
+	GoalUpdatesResponse
+}
+
+// @Summary		Collect Egg
+// @Description	Pick up an egg another player dropped. The caller's last reported location must be within the configured hatch distance of the egg. The egg moves to the caller's inventory and keeps incubating there; the caller earns half of the egg type's hatch reward and the dropper a quarter. Shielded eggs cannot be collected.
+// @Tags		game
+// @Produce		json
+// @Security	BearerAuth
+// @Param		id		path		string	true	"Egg inventory ID"
+// @Success		200		{object}	CollectEggResponse
+// @Failure		400		{object}	ErrorResponse
+// @Failure		401		{object}	ErrorResponse
+// @Failure		403		{object}	ErrorResponse
+// @Failure		404		{object}	ErrorResponse
+// @Failure		409		{object}	ErrorResponse
+// @Failure		428		{object}	ErrorResponse
+// @Failure		429		{object}	ErrorResponse
+// @Failure		500		{object}	ErrorResponse
+// @Router		/game/eggs/{id}/collect [post]
+func (s *Server) CollectEgg(ctx *gin.Context) {
+	eggID, ok := parseUUID(ctx, ctx.Param("id"), "egg id")
+	if !ok {
+		return
+	}
+
+	player, ok := s.currentPlayer(ctx)
+	if !ok {
+		return
+	}
+
+	position, ok := s.lastKnownPosition(ctx, player.ID)
+	if !ok {
+		return
+	}
+
+	egg, err := s.db.GetEgg(ctx, eggID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, HandleError(nil, http.StatusNotFound, "Egg not found"))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch egg"))
+		return
+	}
+
+	eggType, err := s.db.GetEggType(ctx, egg.Type)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch egg type"))
+		return
+	}
+
+	boosts, err := s.activeBoosts(ctx, player.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch active boosts"))
+		return
+	}
+
+	finderReward, dropperReward := game.CollectRewards(eggReward(eggType))
+	finderReward = finderReward.Apply(boosts)
+
+	// The rules run against the locked egg, so a concurrent collector sees it
+	// collected once this transaction commits
+	now := util.Now()
+	result, err := s.db.CollectEggTx(ctx, db.CollectEggTxParams{
+		FinderID:     player.ID,
+		EggID:        egg.InventoryID,
+		Lat:          position.Lat,
+		Lon:          position.Lon,
+		CollectedAt:  now,
+		FinderCoins:  finderReward.Coins,
+		FinderXp:     finderReward.XP,
+		DropperCoins: dropperReward.Coins,
+		DropperXp:    dropperReward.XP,
+		Event:        game.Event{Kind: game.EventEggCollected, EggType: egg.Type, At: now},
+		Check: func(locked db.GetEggForCollectRow) error {
+			return game.CollectAttempt{
+				Own:       locked.PlayerID == player.ID,
+				Collected: locked.CollectedAt.Valid,
+				Shielded:  locked.Shielded,
+				State:     game.EggState(locked.State),
+				DecaysAt:  locked.DecaysAt,
+				Distance:  locked.Distance,
+				Reach:     s.config.HatchRadiusMeters,
+				Now:       now,
+			}.Check()
+		},
+	})
+	if err != nil {
+		if handleCollectRuleError(ctx, err) {
+			return
+		}
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusConflict, HandleError(nil, http.StatusConflict, "Egg has no location"))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to collect egg"))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, CollectEggResponse{
+		InventoryID:   egg.InventoryID.String(),
+		Type:          egg.Type,
+		CollectedAt:   result.Egg.CollectedAt.Time,
+		DropperID:     result.DropperID.String(),
+		Reward:        finderReward,
+		DropperReward: dropperReward,
+		Coins:         result.Player.Coins,
+		Xp:            result.Player.Xp,
+		Level:         result.Player.Level,
+		LevelUps:      levelUpResponses(result.LevelUps),
+
+		GoalUpdatesResponse: goalUpdates(result.Goals),
+	})
+}
+
+// collectRuleStatus maps a collect rule code to its HTTP status
+var collectRuleStatus = map[string]int{
+	game.CollectOwnEgg:           http.StatusForbidden,
+	game.CollectShielded:         http.StatusForbidden,
+	game.CollectAlreadyCollected: http.StatusConflict,
+	game.CollectUnavailable:      http.StatusConflict,
+	game.CollectTooFar:           http.StatusForbidden,
+}
+
+// handleCollectRuleError writes the response for a rejected collect and
+// reports whether err was a rule rejection.
+func handleCollectRuleError(ctx *gin.Context, err error) bool {
+	var collectErr *game.CollectError
+	if !errors.As(err, &collectErr) {
+		return false
+	}
+	status := collectRuleStatus[collectErr.Code]
+	ctx.JSON(status, HandleCodedError(collectErr.Code, status, collectErr.Message))
+	return true
+}
@@ -0,0 +1,159 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	db "github.com/0xdbb/eggsplore/internal/database/sqlc"
+	"github.com/0xdbb/eggsplore/internal/game"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// CreatureResponse represents a creature in a player's collection
+type CreatureResponse struct {
+	InventoryID string    `json:"inventory_id"`
+	SpeciesID   int32     `json:"species_id"`
+	SpeciesCode string    `json:"species_code" example:"MOON_BUNNY"`
+	SpeciesName string    `json:"species_name" example:"Moon Bunny"`
+	EggType     string    `json:"egg_type" example:"BUNNY"`
+	Rarity      string    `json:"rarity" example:"RARE"`
+	Nickname    string    `json:"nickname"`
+	EggID       string    `json:"egg_id,omitempty"`
+	HatchedAt   time.Time `json:"hatched_at"`
+}
+
+// DexEntryResponse represents a species in a player's collection view.
+// Name and description stay hidden until the species is discovered.
+type DexEntryResponse struct {
+	SpeciesID   int32  `json:"species_id"`
+	Code        string `json:"code,omitempty"`
+	Name        string `json:"name" example:"???"`
+	EggType     string `json:"egg_type" example:"BUNNY"`
+	Rarity      string `json:"rarity" example:"RARE"`
+	Description string `json:"description,omitempty"`
+	Discovered  bool   `json:"discovered"`
+	Caught      int32  `json:"caught"`
+}
+
+// DexResponse summarises a player's collection
+type DexResponse struct {
+	Discovered int                `json:"discovered"`
+	Total      int                `json:"total"`
+	Entries    []DexEntryResponse `json:"entries"`
+}
+
+// @Summary		Get Player Creatures
+// @Description	Get all creatures hatched by a player
+// @Tags		game
+// @Produce		json
+// @Security	BearerAuth
+// @Param		player_id	query		string	false	"Player ID (defaults to the caller, must be the caller's)"
+// @Success		200		{array}		CreatureResponse
+// @Failure		400		{object}	ErrorResponse
+// @Failure		401		{object}	ErrorResponse
+// @Failure		403		{object}	ErrorResponse
+// @Failure		500		{object}	ErrorResponse
+// @Router		/game/creatures [get]
+// @Router		/game/me/creatures [get]
+func (s *Server) GetPlayerCreatures(ctx *gin.Context) {
+	player, ok := s.requestPlayer(ctx, ctx.Query("player_id"))
+	if !ok {
+		return
+	}
+
+	creatures, err := s.db.ListCreaturesByPlayer(ctx, player.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch creatures"))
+		return
+	}
+
+	rsp := make([]CreatureResponse, 0, len(creatures))
+	for _, c := range creatures {
+		item := CreatureResponse{
+			InventoryID: c.InventoryID.String(),
+			SpeciesID:   c.SpeciesID,
+			SpeciesCode: c.SpeciesCode,
+			SpeciesName: c.SpeciesName,
+			EggType:     c.EggType,
+			Rarity:      c.Rarity,
+			Nickname:    pgtypeToString(c.Nickname),
+			HatchedAt:   c.HatchedAt,
+		}
+		if c.EggID.Valid {
+			item.EggID = uuid.UUID(c.EggID.Bytes).String()
+		}
+		rsp = append(rsp, item)
+	}
+
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+// @Summary		Get Player Dex
+// @Description	Get every species with whether the player has discovered it and how many they have caught
+// @Tags		game
+// @Produce		json
+// @Security	BearerAuth
+// @Param		player_id	query		string	false	"Player ID (defaults to the caller, must be the caller's)"
+// @Success		200		{object}	DexResponse
+// @Failure		400		{object}	ErrorResponse
+// @Failure		401		{object}	ErrorResponse
+// @Failure		403		{object}	ErrorResponse
+// @Failure		500		{object}	ErrorResponse
+// @Router		/game/dex [get]
+// @Router		/game/me/dex [get]
+func (s *Server) GetPlayerDex(ctx *gin.Context) {
+	player, ok := s.requestPlayer(ctx, ctx.Query("player_id"))
+	if !ok {
+		return
+	}
+
+	entries, err := s.db.GetPlayerDex(ctx, player.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch dex"))
+		return
+	}
+
+	rsp := DexResponse{Total: len(entries), Entries: make([]DexEntryResponse, 0, len(entries))}
+	for _, e := range entries {
+		entry := DexEntryResponse{
+			SpeciesID:  e.ID,
+			Name:       "???",
+			EggType:    e.EggType,
+			Rarity:     e.Rarity,
+			Discovered: e.Caught > 0,
+			Caught:     e.Caught,
+		}
+		if entry.Discovered {
+			entry.Code = e.Code
+			entry.Name = e.Name
+			entry.Description = pgtypeToString(e.Description)
+			rsp.Discovered++
+		}
+		rsp.Entries = append(rsp.Entries, entry)
+	}
+
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+// rollSpecies picks a species for the egg type by weight.
+// It returns nil when no species exist for the type.
+func (s *Server) rollSpecies(ctx context.Context, eggType string) (*db.Species, error) {
+	species, err := s.db.ListSpeciesByEggType(ctx, eggType)
+	if err != nil {
+		return nil, err
+	}
+
+	weights := make([]int32, len(species))
+	for i, sp := range species {
+		weights[i] = sp.Weight
+	}
+
+	idx := game.WeightedIndex(weights)
+	if idx < 0 {
+		return nil, nil
+	}
+	return &species[idx], nil
+}
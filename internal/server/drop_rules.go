@@ -0,0 +1,109 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	db "github.com/0xdbb/eggsplore/internal/database/sqlc"
+	"github.com/0xdbb/eggsplore/internal/game"
+	"github.com/0xdbb/eggsplore/util"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+func (s *Server) dropRules() game.DropRules {
+	return game.DropRules{
+		Cooldown:         s.config.DropCooldown,
+		MaxActiveEggs:    int32(s.config.MaxActiveEggs),
+		MinSpacingMeters: s.config.MinEggSpacingMeters,
+	}
+}
+
+// checkDropRules returns the DropEggTx check enforcing the drop rules for
+// an egg of eggType dropped by playerID at lat/lon.
+func (s *Server) checkDropRules(ctx context.Context, playerID uuid.UUID, eggType db.EggTypes, lat, lon float64) func(q *db.Queries) error {
+	rules := s.dropRules()
+
+	return func(q *db.Queries) error {
+		player, err := q.GetPlayer(ctx, playerID)
+		if err != nil {
+			return err
+		}
+		stats, err := q.GetPlayerDropStats(ctx, playerID)
+		if err != nil {
+			return err
+		}
+		unlocked, err := q.IsEggTypeUnlocked(ctx, db.IsEggTypeUnlockedParams{
+			PlayerID: playerID,
+			Code:     eggType.Code,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = rules.Check(game.DropAttempt{
+			Level:         player.Level,
+			MinLevel:      eggType.MinLevel,
+			Locked:        !unlocked,
+			Coins:         player.Coins,
+			Cost:          eggType.CoinCost,
+			ActiveEggs:    stats.ActiveEggs,
+			LastDroppedAt: stats.LastDroppedAt,
+			Now:           util.Now(),
+		})
+		if err != nil {
+			return err
+		}
+
+		excluded, err := q.IsPointExcluded(ctx, db.IsPointExcludedParams{Lon: lon, Lat: lat})
+		if err != nil {
+			return err
+		}
+		if excluded {
+			return game.ExcludedArea()
+		}
+
+		if rules.MinSpacingMeters > 0 {
+			near, err := q.HasEggWithin(ctx, db.HasEggWithinParams{
+				Lon:      lon,
+				Lat:      lat,
+				Distance: rules.MinSpacingMeters,
+			})
+			if err != nil {
+				return err
+			}
+			if near {
+				return rules.TooClose()
+			}
+		}
+		return nil
+	}
+}
+
+// dropRuleStatus maps a drop rule code to its HTTP status
+var dropRuleStatus = map[string]int{
+	game.DropLevelTooLow:       http.StatusForbidden,
+	game.DropEggTypeLocked:     http.StatusForbidden,
+	game.DropInsufficientCoins: http.StatusPaymentRequired,
+	game.DropCooldown:          http.StatusTooManyRequests,
+	game.DropTooManyActive:     http.StatusConflict,
+	game.DropTooClose:          http.StatusConflict,
+	game.DropExcludedArea:      http.StatusForbidden,
+}
+
+// handleDropRuleError writes the response for a rejected drop and reports
+// whether err was a rule rejection.
+func handleDropRuleError(ctx *gin.Context, err error) bool {
+	if errors.Is(err, db.ErrInsufficientCoins) {
+		err = &game.DropError{Code: game.DropInsufficientCoins, Message: "Not enough coins"}
+	}
+
+	var dropErr *game.DropError
+	if !errors.As(err, &dropErr) {
+		return false
+	}
+	ctx.JSON(dropRuleStatus[dropErr.Code], HandleCodedError(dropErr.Code, dropRuleStatus[dropErr.Code], dropErr.Message))
+	return true
+}
@@ -0,0 +1,89 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	db "github.com/0xdbb/eggsplore/internal/database/sqlc"
+	"github.com/0xdbb/eggsplore/internal/game"
+
+	"github.com/gin-gonic/gin"
+)
+
+// EggTypeResponse represents an entry of the egg type catalog
+type EggTypeResponse struct {
+	Code              string         `json:"code" example:"DRAGON"`
+	Name              string         `json:"name" example:"Dragon Egg"`
+	Rarity            string         `json:"rarity" example:"EPIC"`
+	CoinCost          int64          `json:"coin_cost"`
+	IncubationSeconds int32          `json:"incubation_seconds"`
+	DecaySeconds      int32          `json:"decay_seconds"`
+	MinLevel          int32          `json:"min_level"`
+	RequiresUnlock    bool           `json:"requires_unlock"`
+	Reward            game.EggReward `json:"reward"`
+}
+
+// @Summary		List Egg Types
+// @Description	List the egg types that can currently be dropped
+// @Tags		game
+// @Produce		json
+// @Security	BearerAuth
+// @Success		200		{array}		EggTypeResponse
+// @Failure		401		{object}	ErrorResponse
+// @Failure		500		{object}	ErrorResponse
+// @Router		/game/egg-types [get]
+func (s *Server) ListEggTypes(ctx *gin.Context) {
+	types, err := s.db.ListEnabledEggTypes(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch egg types"))
+		return
+	}
+
+	rsp := make([]EggTypeResponse, 0, len(types))
+	for _, t := range types {
+		rsp = append(rsp, EggTypeResponse{
+			Code:              t.Code,
+			Name:              t.Name,
+			Rarity:            t.Rarity,
+			CoinCost:          t.CoinCost,
+			IncubationSeconds: t.IncubationSeconds,
+			DecaySeconds:      t.DecaySeconds,
+			MinLevel:          t.MinLevel,
+			RequiresUnlock:    t.RequiresUnlock,
+			Reward:            eggReward(t),
+		})
+	}
+
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+// droppableEggType looks up an enabled egg type, writing a 400 response if
+// the code is unknown or disabled.
+func (s *Server) droppableEggType(ctx *gin.Context, code string) (db.EggTypes, bool) {
+	eggType, err := s.db.GetEggType(ctx, code)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusBadRequest, HandleError(nil, http.StatusBadRequest, "Unknown egg type"))
+			return db.EggTypes{}, false
+		}
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch egg type"))
+		return db.EggTypes{}, false
+	}
+	if !eggType.Enabled {
+		ctx.JSON(http.StatusBadRequest, HandleError(nil, http.StatusBadRequest, "Egg type is not available"))
+		return db.EggTypes{}, false
+	}
+	return eggType, true
+}
+
+func eggLifecycle(t db.EggTypes) game.EggLifecycle {
+	return game.EggLifecycle{
+		Incubation: time.Duration(t.IncubationSeconds) * time.Second,
+		Decay:      time.Duration(t.DecaySeconds) * time.Second,
+	}
+}
+
+func eggReward(t db.EggTypes) game.EggReward {
+	return game.EggReward{XP: t.HatchXp, Coins: t.HatchCoins}
+}
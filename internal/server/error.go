@@ -5,6 +5,8 @@ type ErrorResponse struct {
 	Status  string `json:"status"`
 	Message string `json:"message"`
 	Code    int    `json:"code"`
+	// ErrorCode is a machine-readable reason, set for rule rejections
+	ErrorCode string `json:"error_code,omitempty" example:"DROP_COOLDOWN"`
 }
 
 type UserMessage struct {
@@ -41,6 +43,13 @@ func HandleError(err error, code int, message ...string) ErrorResponse {
 	}
 }
 
+// HandleCodedError is HandleError for rejections clients need to tell apart
+func HandleCodedError(errorCode string, code int, message string) ErrorResponse {
+	rsp := HandleError(nil, code, message)
+	rsp.ErrorCode = errorCode
+	return rsp
+}
+
 func HandleMessage(message string) UserMessage {
 	return UserMessage{Message: message}
 }
@@ -0,0 +1,26 @@
+package server
+
+import (
+	db "github.com/0xdbb/eggsplore/internal/database/sqlc"
+)
+
+// GoalUpdatesResponse lists the goals a game action completed
+type GoalUpdatesResponse struct {
+	UnlockedAchievements []string `json:"unlocked_achievements"` // codes of achievements now claimable
+	CompletedQuests      []string `json:"completed_quests"`      // codes of quests now claimable
+}
+
+// goalUpdates lists the goals an event recorded with its action completed
+func goalUpdates(result db.EventResult) GoalUpdatesResponse {
+	rsp := GoalUpdatesResponse{
+		UnlockedAchievements: []string{},
+		CompletedQuests:      []string{},
+	}
+	for _, a := range result.Unlocked {
+		rsp.UnlockedAchievements = append(rsp.UnlockedAchievements, a.Code)
+	}
+	for _, q := range result.Completed {
+		rsp.CompletedQuests = append(rsp.CompletedQuests, q.Template.Code)
+	}
+	return rsp
+}
@@ -1,10 +1,17 @@
 package server
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"time"
 
 	db "github.com/0xdbb/eggsplore/internal/database/sqlc"
+	"github.com/0xdbb/eggsplore/internal/game"
+	"github.com/0xdbb/eggsplore/token"
+	"github.com/0xdbb/eggsplore/util"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -12,11 +19,12 @@ import (
 
 // DropEggRequest represents a player dropping an egg
 type DropEggRequest struct {
-	PlayerID string  `json:"player_id" binding:"required"`
-	Type     string  `json:"type" binding:"required,oneof=BUNNY GOLDEN LEGENDARY"`
-	Message  string  `json:"message"`
-	Lat      float64 `json:"lat" binding:"required"`
-	Lon      float64 `json:"lon" binding:"required"`
+	PlayerID string   `json:"player_id"` // optional, must be the caller's
+	Type     string   `json:"type" binding:"required" example:"DRAGON"`
+	Message  string   `json:"message"`
+	Lat      float64  `json:"lat" binding:"required"`
+	Lon      float64  `json:"lon" binding:"required"`
+	Accuracy *float64 `json:"accuracy" binding:"omitempty,gte=0,lte=10000" example:"8.5"` // meters, as reported by the device
 }
 
 // DropEggResponse represents the egg created
@@ -26,19 +34,37 @@ type DropEggResponse struct {
 	Message     string    `json:"message"`
 	Lat         float64   `json:"lat"`
 	Lon         float64   `json:"lon"`
+	State       string    `json:"state" example:"PLANTED"`
+	ReadyAt     time.Time `json:"ready_at"`
+	DecaysAt    time.Time `json:"decays_at"`
+	Cost        int64     `json:"cost"`
+	Coins       int64     `json:"coins"` // player's balance after the drop
+	Shielded    bool      `json:"shielded"`
 	CreatedAt   time.Time `json:"created_at"`
+	// Tool is the equipped shield that protects the egg, if any
+	Tool *ToolWearResponse `json:"tool,omitempty"`
+	GoalUpdatesResponse
 }
 
 // @Summary		Drop Egg
-// @Description	Player drops an egg (adds to inventory + eggs table)
+// @Description	Player drops an egg (adds to inventory + eggs table). The egg type's coin cost is charged.
+// @Description	Rejections carry an error_code: DROP_LEVEL_TOO_LOW, DROP_EGG_TYPE_LOCKED, DROP_INSUFFICIENT_COINS, DROP_COOLDOWN, DROP_TOO_MANY_ACTIVE_EGGS, DROP_TOO_CLOSE_TO_EGG or DROP_EXCLUDED_AREA.
+// @Description	The drop location is checked against the caller's recent locations; one that looks faked is rejected (LOCATION_REJECTED) or holds the caller's location based actions for a while (LOCATION_ON_HOLD).
 // @Tags		game
 // @Accept		json
 // @Produce		json
+// @Security	BearerAuth
 // @Param		request	body		DropEggRequest	true	"Drop Egg Request"
 // @Success		200		{object}	DropEggResponse
 // @Failure		400		{object}	ErrorResponse
+// @Failure		401		{object}	ErrorResponse
+// @Failure		402		{object}	ErrorResponse
+// @Failure		403		{object}	ErrorResponse
+// @Failure		409		{object}	ErrorResponse
+// @Failure		429		{object}	ErrorResponse
 // @Failure		500		{object}	ErrorResponse
 // @Router		/game/eggs [post]
+// @Router		/game/me/eggs [post]
 // DropEggRequest represents a player dropping an egg
 func (s *Server) DropEgg(ctx *gin.Context) {
 	var req DropEggRequest
@@ -51,33 +77,76 @@ func (s *Server) DropEgg(ctx *gin.Context) {
 		return
 	}
 
-	playerUUID, ok := parseUUID(ctx, req.PlayerID, "player_id")
+	player, ok := s.requestPlayer(ctx, req.PlayerID)
 	if !ok {
 		return
 	}
 
-	// Step 1: create inventory row
-	inv, err := s.db.CreateEgg(ctx, db.CreateEggParams{
-		PlayerID:    playerUUID,
-		Description: stringToPgtype(req.Message),
-	})
+	// The drop location joins the trail like a reported one, unless it is
+	// rejected
+	fix := game.Fix{Lat: req.Lat, Lon: req.Lon, Accuracy: -1, At: util.Now()}
+	if req.Accuracy != nil {
+		fix.Accuracy = *req.Accuracy
+	}
+	verdict, ok := s.screenLocation(ctx, player.ID, fix, sourceDrop)
+	if !ok {
+		return
+	}
+	if _, err := s.recordLocation(ctx, player.ID, fix, verdict); err != nil {
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to store location"))
+		return
+	}
+	if !s.checkLocationHold(ctx, player.ID) {
+		return
+	}
+
+	eggType, ok := s.droppableEggType(ctx, req.Type)
+	if !ok {
+		return
+	}
+	boosts, err := s.activeBoosts(ctx, player.ID)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to create inventory egg"))
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch active boosts"))
 		return
 	}
+	schedule := game.NewEggSchedule(util.Now(), eggLifecycle(eggType).Apply(boosts))
 
-	// Step 2: insert egg details including location
-	egg, err := s.db.AddEggDetails(ctx, db.AddEggDetailsParams{
-		InventoryID: inv.ID,
-		Type:        req.Type,
-		Message:     stringToPgtype(req.Message),
-		Lat:         req.Lat,
-		Lon:         req.Lon,
+	shield, err := s.equippedTool(ctx, player.ID, game.ToolShield)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch equipped tools"))
+		return
+	}
+
+	// Rules, charge, inventory row, egg details (including location) and goal progress run in one transaction
+	result, err := s.db.DropEggTx(ctx, db.DropEggTxParams{
+		PlayerID: player.ID,
+		Egg: db.AddEggDetailsParams{
+			Type:        eggType.Code,
+			Message:     stringToPgtype(req.Message),
+			Lat:         req.Lat,
+			Lon:         req.Lon,
+			PlantedAt:   schedule.PlantedAt,
+			IncubatesAt: schedule.IncubatesAt,
+			ReadyAt:     schedule.ReadyAt,
+			DecaysAt:    schedule.DecaysAt,
+		},
+		Cost:  eggType.CoinCost,
+		Tool:  shield,
+		Event: game.Event{Kind: game.EventEggDropped, EggType: eggType.Code, At: schedule.PlantedAt},
+		Check: s.checkDropRules(ctx, player.ID, eggType, req.Lat, req.Lon),
 	})
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to add egg details"))
+		if handleDropRuleError(ctx, err) {
+			return
+		}
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusConflict, HandleError(nil, http.StatusConflict, "Equipment changed during the drop, please retry"))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to drop egg"))
 		return
 	}
+	inv, egg := result.Inventory, result.Egg
 
 	ctx.JSON(http.StatusOK, DropEggResponse{
 		InventoryID: inv.ID.String(),
@@ -85,74 +154,554 @@ func (s *Server) DropEgg(ctx *gin.Context) {
 		Message:     egg.Message.String,
 		Lat:         req.Lat,
 		Lon:         req.Lon,
+		State:       egg.State,
+		ReadyAt:     egg.ReadyAt,
+		DecaysAt:    egg.DecaysAt,
+		Cost:        eggType.CoinCost,
+		Coins:       result.Player.Coins,
+		Shielded:    egg.Shielded,
 		CreatedAt:   inv.CreatedAt,
+		Tool:        toolWearResponse(result.Tool),
+
+		GoalUpdatesResponse: goalUpdates(result.Goals),
 	})
 }
 
+// HatchEggResponse represents what came out of a hatched egg
+type HatchEggResponse struct {
+	InventoryID string            `json:"inventory_id"`
+	Type        string            `json:"type"`
+	State       string            `json:"state" example:"HATCHED"`
+	HatchedAt   time.Time         `json:"hatched_at"`
+	Reward      game.EggReward    `json:"reward"`
+	Creature    *CreatureResponse `json:"creature,omitempty"`
+	Coins       int64             `json:"coins"` // player's balance after the reward
+	Xp          int64             `json:"xp"`
+	Tool        *ToolWearResponse `json:"tool,omitempty"` // equipped shovel used, if any
+	Level       int32             `json:"level"`
+	LevelUps    []LevelUpResponse `json:"level_ups"` // levels reached through the hatch XP
+	GoalUpdatesResponse
+}
+
+// LevelUpResponse represents a level reached and the rewards it granted
+type LevelUpResponse struct {
+	Level        int32    `json:"level" example:"3"`
+	Coins        int64    `json:"coins" example:"75"`
+	InventoryIDs []string `json:"inventory_ids"`
+	EggType      string   `json:"egg_type,omitempty"`
+}
+
+// @Summary		Hatch Egg
+// @Description	Hatch one of the caller's READY eggs. Unless the egg was collected, the caller's last reported location must be within the configured distance of it, extended by an equipped shovel. A creature rolled from the egg type's species joins the caller's collection.
+// @Tags		game
+// @Produce		json
+// @Security	BearerAuth
+// @Param		id		path		string	true	"Egg inventory ID"
+// @Success		200		{object}	HatchEggResponse
+// @Failure		400		{object}	ErrorResponse
+// @Failure		401		{object}	ErrorResponse
+// @Failure		403		{object}	ErrorResponse
+// @Failure		404		{object}	ErrorResponse
+// @Failure		409		{object}	ErrorResponse
+// @Failure		428		{object}	ErrorResponse
+// @Failure		429		{object}	ErrorResponse
+// @Failure		500		{object}	ErrorResponse
+// @Router		/game/eggs/{id}/hatch [post]
+func (s *Server) HatchEgg(ctx *gin.Context) {
+	eggID, ok := parseUUID(ctx, ctx.Param("id"), "egg id")
+	if !ok {
+		return
+	}
+
+	player, ok := s.currentPlayer(ctx)
+	if !ok {
+		return
+	}
+
+	egg, err := s.db.GetEgg(ctx, eggID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, HandleError(nil, http.StatusNotFound, "Egg not found"))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch egg"))
+		return
+	}
+
+	if egg.PlayerID != player.ID {
+		ctx.JSON(http.StatusForbidden, HandleError(nil, http.StatusForbidden, "Egg does not belong to you"))
+		return
+	}
+
+	now := util.Now()
+	schedule := game.EggSchedule{
+		PlantedAt:   egg.PlantedAt,
+		IncubatesAt: egg.IncubatesAt,
+		ReadyAt:     egg.ReadyAt,
+		DecaysAt:    egg.DecaysAt,
+	}
+	state, err := s.advanceEgg(ctx, egg.InventoryID, game.EggState(egg.State), schedule, now)
+	if err != nil {
+		if errors.Is(err, errEggStateChanged) {
+			ctx.JSON(http.StatusConflict, HandleError(err, http.StatusConflict, "Egg was updated concurrently, please retry"))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to update egg state"))
+		return
+	}
+
+	if !game.CanTransition(state, game.EggHatched) {
+		ctx.JSON(http.StatusConflict, HandleError(nil, http.StatusConflict, "Egg is "+string(state)+" and cannot be hatched"))
+		return
+	}
+
+	// A collected egg is carried by the finder, one still in the world must
+	// be dug out on the spot. An equipped shovel digs from further away.
+	var shovel *db.ToolUse
+	if !egg.CollectedAt.Valid {
+		position, ok := s.lastKnownPosition(ctx, player.ID)
+		if !ok {
+			return
+		}
+
+		shovel, err = s.equippedTool(ctx, player.ID, game.ToolShovel)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch equipped tools"))
+			return
+		}
+		reach := s.config.HatchRadiusMeters
+		if shovel != nil {
+			reach += game.ShovelReachMeters
+		}
+
+		proximity, err := s.db.CheckEggProximity(ctx, db.CheckEggProximityParams{
+			Lon:         position.Lon,
+			Lat:         position.Lat,
+			MaxDistance: reach,
+			InventoryID: egg.InventoryID,
+		})
+		if err != nil {
+			if errors.Is(err, db.ErrRecordNotFound) {
+				ctx.JSON(http.StatusConflict, HandleError(nil, http.StatusConflict, "Egg has no location"))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to check distance to egg"))
+			return
+		}
+		if !proximity.Within {
+			ctx.JSON(http.StatusForbidden, HandleError(fmt.Errorf("%.0fm away, must be within %.0fm", proximity.Distance, reach), http.StatusForbidden, "Too far from egg"))
+			return
+		}
+	}
+
+	species, err := s.rollSpecies(ctx, egg.Type)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to roll species"))
+		return
+	}
+
+	eggType, err := s.db.GetEggType(ctx, egg.Type)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch egg type"))
+		return
+	}
+
+	boosts, err := s.activeBoosts(ctx, player.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch active boosts"))
+		return
+	}
+
+	// Hatch, reward, creature and goal progress are stored atomically
+	reward := eggReward(eggType).Apply(boosts)
+	result, err := s.db.HatchEggTx(ctx, db.HatchEggTxParams{
+		PlayerID:  player.ID,
+		EggID:     egg.InventoryID,
+		FromState: string(state),
+		HatchedAt: now,
+		Coins:     reward.Coins,
+		Xp:        reward.XP,
+		Species:   species,
+		Tool:      shovel,
+		Event:     game.Event{Kind: game.EventEggHatched, EggType: egg.Type, At: now},
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusConflict, HandleError(nil, http.StatusConflict, "Egg was updated concurrently, please retry"))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to hatch egg"))
+		return
+	}
+
+	rsp := HatchEggResponse{
+		InventoryID: egg.InventoryID.String(),
+		Type:        egg.Type,
+		State:       result.Transition.ToState,
+		HatchedAt:   result.Transition.TransitionedAt,
+		Reward:      reward,
+		Coins:       result.Player.Coins,
+		Xp:          result.Player.Xp,
+		Tool:        toolWearResponse(result.Tool),
+		Level:       result.Player.Level,
+		LevelUps:    levelUpResponses(result.LevelUps),
+
+		GoalUpdatesResponse: goalUpdates(result.Goals),
+	}
+	if result.Creature != nil {
+		rsp.Creature = &CreatureResponse{
+			InventoryID: result.Creature.InventoryID.String(),
+			SpeciesID:   species.ID,
+			SpeciesCode: species.Code,
+			SpeciesName: species.Name,
+			EggType:     species.EggType,
+			Rarity:      species.Rarity,
+			EggID:       egg.InventoryID.String(),
+			HatchedAt:   result.Creature.HatchedAt,
+		}
+	}
+
+	ctx.JSON(http.StatusOK, rsp)
+}
+
 // @Summary		Get Player Eggs
-// @Description	Get all eggs belonging to a player
+// @Description	Get all eggs belonging to a player with their lifecycle state and the time left until the next state
 // @Tags		game
 // @Produce		json
-// @Param		player_id	query		string	true	"Player ID"
+// @Security	BearerAuth
+// @Param		player_id	query		string	false	"Player ID (defaults to the caller, must be the caller's)"
 // @Success		200		{array}		GetEggsByPlayerResponse
 // @Failure		400		{object}	ErrorResponse
+// @Failure		401		{object}	ErrorResponse
+// @Failure		403		{object}	ErrorResponse
 // @Failure		500		{object}	ErrorResponse
 // @Router		/game/eggs [get]
+// @Router		/game/me/eggs [get]
 func (s *Server) GetPlayerEggs(ctx *gin.Context) {
-	playerID, ok := parseUUID(ctx, ctx.Query("player_id"), "player_id")
+	player, ok := s.requestPlayer(ctx, ctx.Query("player_id"))
 	if !ok {
 		return
 	}
 
-	eggs, err := s.db.GetEggsByPlayer(ctx, playerID)
+	eggs, err := s.db.GetEggsByPlayer(ctx, player.ID)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch eggs"))
 		return
 	}
 
-	ctx.JSON(http.StatusOK, eggs)
+	now := util.Now()
+	rsp := make([]GetEggsByPlayerResponse, 0, len(eggs))
+	for _, egg := range eggs {
+		schedule := game.EggSchedule{
+			PlantedAt:   egg.PlantedAt,
+			IncubatesAt: egg.IncubatesAt,
+			ReadyAt:     egg.ReadyAt,
+			DecaysAt:    egg.DecaysAt,
+		}
+
+		// Listing only reads, the due transitions are stored by the next
+		// action on the egg
+		state := schedule.StateAt(game.EggState(egg.State), now)
+
+		item := GetEggsByPlayerResponse{
+			InventoryID: egg.InventoryID.String(),
+			Type:        egg.Type,
+			Hatched:     state == game.EggHatched,
+			Message:     pgtypeToString(egg.Message),
+			CollectedAt: egg.CollectedAt.Time,
+			State:       string(state),
+			PlantedAt:   egg.PlantedAt,
+		}
+		if next, at, ok := schedule.Next(state); ok {
+			item.NextState = string(next)
+			item.NextStateAt = &at
+			item.TimeLeft = int64(max(at.Sub(now), 0).Seconds())
+		}
+		rsp = append(rsp, item)
+	}
+
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+const (
+	defaultNearbyRadius = 500.0  // meters
+	maxNearbyRadius     = 5000.0 // meters
+	defaultNearbyLimit  = 50
+	maxNearbyLimit      = 200
+)
+
+// NearbyEggsRequest represents a geospatial egg search around the caller.
+// When all bounding box fields are set the search is limited to the box,
+// otherwise eggs within Radius meters of the caller are returned.
+type NearbyEggsRequest struct {
+	Lat    float64  `form:"lat" binding:"required,gte=-90,lte=90"`
+	Lon    float64  `form:"lon" binding:"required,gte=-180,lte=180"`
+	Radius float64  `form:"radius" binding:"omitempty,gt=0"`
+	XMin   *float64 `form:"xmin" binding:"omitempty,gte=-180,lte=180"`
+	YMin   *float64 `form:"ymin" binding:"omitempty,gte=-90,lte=90"`
+	XMax   *float64 `form:"xmax" binding:"omitempty,gte=-180,lte=180"`
+	YMax   *float64 `form:"ymax" binding:"omitempty,gte=-90,lte=90"`
+	Limit  int32    `form:"limit" binding:"omitempty,gte=1"`
+	Offset int32    `form:"offset" binding:"omitempty,gte=0"`
+}
+
+// boundingBox returns the requested bounding box, if one was fully supplied.
+func (r NearbyEggsRequest) boundingBox() (util.BoundingBox, bool) {
+	if r.XMin == nil || r.YMin == nil || r.XMax == nil || r.YMax == nil {
+		return util.BoundingBox{}, false
+	}
+	return util.BoundingBox{XMin: *r.XMin, YMin: *r.YMin, XMax: *r.XMax, YMax: *r.YMax}, true
+}
+
+// NearbyEggResponse represents an egg found around the caller. Other
+// players' eggs come without owner, placed at the center of their blur cell.
+type NearbyEggResponse struct {
+	InventoryID   string  `json:"inventory_id"`
+	OwnerID       string  `json:"owner_id,omitempty"`
+	OwnerUsername string  `json:"owner_username,omitempty"`
+	Type          string  `json:"type"`
+	Message       string  `json:"message"`
+	Lat           float64 `json:"lat"`
+	Lon           float64 `json:"lon"`
+	Distance      float64 `json:"distance" example:"42.5"` // meters
+}
+
+// @Summary		Get Nearby Eggs
+// @Description	Get unhatched eggs around a position, sorted by distance. Searches within a radius (meters) or, when xmin/ymin/xmax/ymax are all given, within that bounding box, which must lie within the radius the caller could search. Only the caller's own eggs come with their owner and exact position; other players' eggs are searched, placed and measured at the center of the roughly 550m grid cell they lie in.
+// @Tags		game
+// @Produce		json
+// @Security	BearerAuth
+// @Param		lat		query		number	true	"Latitude of the caller"
+// @Param		lon		query		number	true	"Longitude of the caller"
+// @Param		radius	query		number	false	"Search radius in meters (default 500, max 5000, extended by discovery boosts and an equipped radar)"
+// @Param		xmin	query		number	false	"Bounding box min longitude"
+// @Param		ymin	query		number	false	"Bounding box min latitude"
+// @Param		xmax	query		number	false	"Bounding box max longitude"
+// @Param		ymax	query		number	false	"Bounding box max latitude"
+// @Param		limit	query		int		false	"Page size (default 50, max 200)"
+// @Param		offset	query		int		false	"Page offset"
+// @Success		200		{array}		NearbyEggResponse
+// @Failure		400		{object}	ErrorResponse
+// @Failure		401		{object}	ErrorResponse
+// @Failure		403		{object}	ErrorResponse
+// @Failure		500		{object}	ErrorResponse
+// @Router		/game/eggs/nearby [get]
+func (s *Server) GetNearbyEggs(ctx *gin.Context) {
+	var req NearbyEggsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		if valErr := HandleValidationError(err); valErr != nil {
+			ctx.JSON(http.StatusBadRequest, valErr)
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, HandleError(nil, http.StatusBadRequest, "Invalid request format"))
+		return
+	}
+
+	player, ok := s.currentPlayer(ctx)
+	if !ok {
+		return
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultNearbyLimit
+	}
+	limit = min(limit, maxNearbyLimit)
+
+	var eggs []db.ListEggsWithinRadiusRow
+	var radar *db.ToolUse
+	if bbox, ok := req.boundingBox(); ok {
+		if bbox.XMin >= bbox.XMax || bbox.YMin >= bbox.YMax {
+			ctx.JSON(http.StatusBadRequest, HandleError(nil, http.StatusBadRequest, "Invalid bounding box"))
+			return
+		}
+
+		// The box must lie within the range the caller could search with a
+		// radius, so it cannot scan further than a radius search can
+		reach := bbox.Reach(util.Coord{Lat: req.Lat, Lon: req.Lon})
+		var maxRadius float64
+		maxRadius, radar, ok = s.searchRange(ctx, player.ID, reach)
+		if !ok {
+			return
+		}
+		if reach > maxRadius {
+			ctx.JSON(http.StatusBadRequest, HandleError(fmt.Errorf("box reaches %.0fm, search range is %.0fm", reach, maxRadius), http.StatusBadRequest, "Bounding box reaches beyond your search range"))
+			return
+		}
+
+		rows, err := s.db.ListEggsInBoundingBox(ctx, db.ListEggsInBoundingBoxParams{
+			Lon:        req.Lon,
+			Lat:        req.Lat,
+			PlayerID:   player.ID,
+			BlurCell:   game.BlurCellDegrees,
+			Xmin:       bbox.XMin,
+			Ymin:       bbox.YMin,
+			Xmax:       bbox.XMax,
+			Ymax:       bbox.YMax,
+			PageLimit:  limit,
+			PageOffset: req.Offset,
+		})
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch nearby eggs"))
+			return
+		}
+		for _, row := range rows {
+			eggs = append(eggs, db.ListEggsWithinRadiusRow(row))
+		}
+	} else {
+		radius := req.Radius
+		if radius == 0 {
+			radius = defaultNearbyRadius
+		}
+
+		var maxRadius float64
+		maxRadius, radar, ok = s.searchRange(ctx, player.ID, radius)
+		if !ok {
+			return
+		}
+		radius = min(radius, maxRadius)
+
+		var err error
+		eggs, err = s.db.ListEggsWithinRadius(ctx, db.ListEggsWithinRadiusParams{
+			Lon:        req.Lon,
+			Lat:        req.Lat,
+			PlayerID:   player.ID,
+			BlurCell:   game.BlurCellDegrees,
+			Radius:     radius,
+			BlurMargin: game.BlurMarginMeters,
+			PageLimit:  limit,
+			PageOffset: req.Offset,
+		})
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch nearby eggs"))
+			return
+		}
+	}
+
+	if radar != nil {
+		if _, err := s.db.WearToolTx(ctx, *radar); err != nil && !errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to use radar"))
+			return
+		}
+	}
+
+	rsp := make([]NearbyEggResponse, 0, len(eggs))
+	for _, egg := range eggs {
+		rsp = append(rsp, nearbyEggFromRow(egg, player.ID))
+	}
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+// searchRange returns how far around the caller a search may reach when it
+// wants to reach wanted meters. Beyond the normal range it takes a discovery
+// boost or an equipped radar; the radar is returned when it is needed, so the
+// search can wear it down. It writes the error response and returns false
+// when the range cannot be determined.
+func (s *Server) searchRange(ctx *gin.Context, playerID uuid.UUID, wanted float64) (float64, *db.ToolUse, bool) {
+	maxRadius := maxNearbyRadius
+	if wanted <= maxRadius {
+		return maxRadius, nil, true
+	}
+
+	boosts, err := s.activeBoosts(ctx, playerID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch active boosts"))
+		return 0, nil, false
+	}
+	maxRadius *= boosts.Multiplier(game.BoostDiscovery)
+	if wanted <= maxRadius {
+		return maxRadius, nil, true
+	}
+
+	radar, err := s.equippedTool(ctx, playerID, game.ToolRadar)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch equipped tools"))
+		return 0, nil, false
+	}
+	if radar != nil {
+		maxRadius *= game.RadarRangeMultiplier
+	}
+	return maxRadius, radar, true
+}
+
+// nearbyEggFromRow names the owner only to the owner. The query already
+// placed other players' eggs at their blur cell.
+func nearbyEggFromRow(egg db.ListEggsWithinRadiusRow, playerID uuid.UUID) NearbyEggResponse {
+	rsp := NearbyEggResponse{
+		InventoryID: egg.InventoryID.String(),
+		Type:        egg.Type,
+		Message:     pgtypeToString(egg.Message),
+		Lat:         egg.Lat,
+		Lon:         egg.Lon,
+		Distance:    egg.Distance,
+	}
+	if egg.OwnerID == playerID {
+		rsp.OwnerID = egg.OwnerID.String()
+		rsp.OwnerUsername = pgtypeToString(egg.OwnerUsername)
+	}
+	return rsp
 }
 
 // @Summary		Get Player Tools
 // @Description	Get all tools belonging to a player
 // @Tags		game
 // @Produce		json
-// @Param		player_id	query		string	true	"Player ID"
+// @Security	BearerAuth
+// @Param		player_id	query		string	false	"Player ID (defaults to the caller, must be the caller's)"
 // @Success		200		{array}		GetToolsByPlayerResponse
 // @Failure		400		{object}	ErrorResponse
+// @Failure		401		{object}	ErrorResponse
+// @Failure		403		{object}	ErrorResponse
 // @Failure		500		{object}	ErrorResponse
 // @Router		/game/tools [get]
+// @Router		/game/me/tools [get]
 func (s *Server) GetPlayerTools(ctx *gin.Context) {
-	playerID, ok := parseUUID(ctx, ctx.Query("player_id"), "player_id")
+	player, ok := s.requestPlayer(ctx, ctx.Query("player_id"))
 	if !ok {
 		return
 	}
 
-	tools, err := s.db.GetToolsByPlayer(ctx, playerID)
+	tools, err := s.db.GetToolsByPlayer(ctx, player.ID)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch tools"))
 		return
 	}
 
-	ctx.JSON(http.StatusOK, tools)
+	rsp := make([]GetToolsByPlayerResponse, 0, len(tools))
+	for _, t := range tools {
+		rsp = append(rsp, GetToolsByPlayerResponse{
+			InventoryID: t.InventoryID.String(),
+			Kind:        t.Kind,
+			Slot:        t.Slot,
+			Durability:  t.Durability,
+			Equipped:    t.Equipped,
+			Description: pgtypeToString(t.Description),
+		})
+	}
+
+	ctx.JSON(http.StatusOK, rsp)
 }
 
 // @Summary		Get Player Inventory
 // @Description	Get all inventory items (tools, eggs, boosts) belonging to a player
 // @Tags		game
 // @Produce		json
-// @Param		player_id	query		string	true	"Player ID"
+// @Security	BearerAuth
+// @Param		player_id	query		string	false	"Player ID (defaults to the caller, must be the caller's)"
 // @Success		200		{array}		Inventory
 // @Failure		400		{object}	ErrorResponse
+// @Failure		401		{object}	ErrorResponse
+// @Failure		403		{object}	ErrorResponse
 // @Failure		500		{object}	ErrorResponse
 // @Router		/game/inventory [get]
+// @Router		/game/me/inventory [get]
 func (s *Server) GetPlayerInventory(ctx *gin.Context) {
-	playerID, ok := parseUUID(ctx, ctx.Query("player_id"), "player_id")
+	player, ok := s.requestPlayer(ctx, ctx.Query("player_id"))
 	if !ok {
 		return
 	}
 
-	inv, err := s.db.GetInventoryByPlayer(ctx, playerID)
+	inv, err := s.db.GetInventoryByPlayer(ctx, player.ID)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch inventory"))
 		return
@@ -162,27 +711,165 @@ func (s *Server) GetPlayerInventory(ctx *gin.Context) {
 }
 
 // @Summary		Get Player Stats
-// @Description	Get player stats by account id
+// @Description	Get player stats by account id, with the XP into the current level, the XP needed for the next one, the login streak and the next daily reward
 // @Tags		game
 // @Produce		json
-// @Param		account_id	query		string	true	"Account ID"
-// @Success		200		{object}	Players
+// @Security	BearerAuth
+// @Param		account_id	query		string	false	"Account ID (defaults to the caller, must be the caller's)"
+// @Success		200		{object}	PlayerStatsResponse
 // @Failure		400		{object}	ErrorResponse
+// @Failure		401		{object}	ErrorResponse
+// @Failure		403		{object}	ErrorResponse
 // @Failure		500		{object}	ErrorResponse
 // @Router		/game/player [get]
+// @Router		/game/me [get]
 func (s *Server) GetPlayerStats(ctx *gin.Context) {
-	accountID, ok := parseUUID(ctx, ctx.Query("account_id"), "account_id")
+	payload, ok := authPayload(ctx)
+	if !ok {
+		return
+	}
+
+	if raw := ctx.Query("account_id"); raw != "" {
+		accountID, ok := parseUUID(ctx, raw, "account_id")
+		if !ok {
+			return
+		}
+		if accountID != payload.AccountID {
+			ctx.JSON(http.StatusForbidden, HandleError(nil, http.StatusForbidden, "Account does not belong to the authenticated user"))
+			return
+		}
+	}
+
+	player, ok := s.currentPlayer(ctx)
 	if !ok {
 		return
 	}
 
-	player, err := s.db.GetPlayerByAccount(ctx, accountID)
+	streak, err := s.db.LoginStreak(ctx, player, util.Now())
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch player stats"))
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch streak"))
 		return
 	}
 
-	ctx.JSON(http.StatusOK, player)
+	curve := s.db.XPCurve()
+	progress := curve.Progress(player.Xp)
+	ctx.JSON(http.StatusOK, PlayerStatsResponse{
+		Players: Players{
+			ID:        player.ID.String(),
+			AccountID: player.AccountID.String(),
+			Coins:     player.Coins,
+			Xp:        player.Xp,
+			Level:     player.Level,
+			Settings:  json.RawMessage(player.Settings),
+			CreatedAt: player.CreatedAt,
+			UpdatedAt: player.UpdatedAt,
+		},
+		XPIntoLevel:    progress.XPIntoLevel,
+		XPForNextLevel: progress.XPForNext,
+		XPToNextLevel:  progress.XPToNext,
+		MaxLevel:       curve.MaxLevel,
+		Streak:         streakResponse(streak),
+	})
+}
+
+func levelUpResponses(levelUps []db.LevelUp) []LevelUpResponse {
+	rsp := make([]LevelUpResponse, 0, len(levelUps))
+	for _, l := range levelUps {
+		ids := make([]string, 0, len(l.InventoryIDs))
+		for _, id := range l.InventoryIDs {
+			ids = append(ids, id.String())
+		}
+		rsp = append(rsp, LevelUpResponse{
+			Level:        l.Level,
+			Coins:        l.Coins,
+			InventoryIDs: ids,
+			EggType:      l.EggType,
+		})
+	}
+	return rsp
+}
+
+var errEggStateChanged = errors.New("egg state changed")
+
+// advanceEgg persists every timed transition of an egg that is due by now
+// and returns the egg's current state.
+func (s *Server) advanceEgg(ctx context.Context, inventoryID uuid.UUID, current game.EggState, schedule game.EggSchedule, now time.Time) (game.EggState, error) {
+	for _, t := range schedule.Advance(current, now) {
+		_, err := s.db.TransitionEgg(ctx, db.TransitionEggParams{
+			ToState:        string(t.To),
+			InventoryID:    inventoryID,
+			FromState:      string(t.From),
+			TransitionedAt: t.At,
+		})
+		if err != nil {
+			if errors.Is(err, db.ErrRecordNotFound) {
+				return current, errEggStateChanged
+			}
+			return current, err
+		}
+		current = t.To
+	}
+	return current, nil
+}
+
+// authPayload returns the access token payload set by AuthMiddleware.
+// It writes the error response and returns false when there is none.
+func authPayload(ctx *gin.Context) (*token.Payload, bool) {
+	payload, exists := ctx.Get(authorizationPayloadKey)
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, HandleError(nil, http.StatusUnauthorized, "No authorization payload in context"))
+		return nil, false
+	}
+
+	tokenPayload, ok := payload.(*token.Payload)
+	if !ok {
+		ctx.JSON(http.StatusInternalServerError, HandleError(nil, http.StatusInternalServerError, "Invalid payload type"))
+		return nil, false
+	}
+	return tokenPayload, true
+}
+
+// currentPlayer resolves the player of the authenticated account.
+// It writes the error response and returns false when that is not possible.
+func (s *Server) currentPlayer(ctx *gin.Context) (db.Players, bool) {
+	payload, ok := authPayload(ctx)
+	if !ok {
+		return db.Players{}, false
+	}
+
+	player, err := s.db.GetPlayerByAccount(ctx, payload.AccountID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, HandleError(nil, http.StatusNotFound, "Player not found"))
+			return db.Players{}, false
+		}
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch player"))
+		return db.Players{}, false
+	}
+	return player, true
+}
+
+// requestPlayer resolves the player a request acts on. The player always comes
+// from the access token; a player_id sent by the client is only accepted when
+// it is the caller's own, otherwise 403 is returned.
+func (s *Server) requestPlayer(ctx *gin.Context, rawPlayerID string) (db.Players, bool) {
+	player, ok := s.currentPlayer(ctx)
+	if !ok {
+		return db.Players{}, false
+	}
+	if rawPlayerID == "" {
+		return player, true
+	}
+
+	playerID, ok := parseUUID(ctx, rawPlayerID, "player_id")
+	if !ok {
+		return db.Players{}, false
+	}
+	if playerID != player.ID {
+		ctx.JSON(http.StatusForbidden, HandleError(nil, http.StatusForbidden, "Player does not belong to the authenticated user"))
+		return db.Players{}, false
+	}
+	return player, true
 }
 
 func parseUUID(ctx *gin.Context, raw string, fieldName string) (uuid.UUID, bool) {
@@ -0,0 +1,127 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	db "github.com/0xdbb/eggsplore/internal/database/sqlc"
+	"github.com/0xdbb/eggsplore/internal/game"
+	"github.com/0xdbb/eggsplore/util"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// HintRateLimited is returned when a player asks for hints too often
+const HintRateLimited = "HINT_RATE_LIMITED"
+
+// EggHintResponse represents a vague pointer to an egg
+type EggHintResponse struct {
+	InventoryID string           `json:"inventory_id"`
+	Band        game.HintBand    `json:"band" example:"WARM"`
+	Direction   string           `json:"direction" example:"NE"` // compass point from the caller to the egg
+	Area        HintAreaResponse `json:"area"`
+}
+
+// HintAreaResponse represents a circle the egg lies somewhere within
+type HintAreaResponse struct {
+	Center util.Coord `json:"center"`
+	Radius float64    `json:"radius" example:"500"` // meters
+}
+
+// @Summary		Get Egg Hint
+// @Description	Get a hint towards an egg still in the world: how close the caller's last reported location is (HOT, WARM or COLD), the rough direction and a circle the egg lies within. The circle is offset from the egg at random and is the same for every player in every band. Hints are rate limited per player (HINT_RATE_LIMITED).
+// @Tags		game
+// @Produce		json
+// @Security	BearerAuth
+// @Param		id	path		string	true	"Egg inventory ID"
+// @Success		200	{object}	EggHintResponse
+// @Failure		400	{object}	ErrorResponse
+// @Failure		401	{object}	ErrorResponse
+// @Failure		404	{object}	ErrorResponse
+// @Failure		428	{object}	ErrorResponse
+// @Failure		429	{object}	ErrorResponse
+// @Failure		500	{object}	ErrorResponse
+// @Router		/game/eggs/{id}/hint [get]
+func (s *Server) GetEggHint(ctx *gin.Context) {
+	eggID, ok := parseUUID(ctx, ctx.Param("id"), "egg id")
+	if !ok {
+		return
+	}
+
+	player, ok := s.currentPlayer(ctx)
+	if !ok {
+		return
+	}
+
+	position, ok := s.lastKnownPosition(ctx, player.ID)
+	if !ok {
+		return
+	}
+
+	hint, err := s.db.GetEggHint(ctx, db.GetEggHintParams{
+		Lon:         position.Lon,
+		Lat:         position.Lat,
+		InventoryID: eggID,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, HandleError(nil, http.StatusNotFound, "Egg not found"))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch egg"))
+		return
+	}
+
+	// Limited per player across all eggs, so polling from different spots
+	// cannot triangulate an egg. Only hints for eggs that can be hinted at
+	// count.
+	limit, err := s.hintLimiter.Get(ctx, player.ID.String())
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to check hint rate limit"))
+		return
+	}
+	ctx.Header("X-RateLimit-Limit", strconv.FormatInt(limit.Limit, 10))
+	ctx.Header("X-RateLimit-Remaining", strconv.FormatInt(limit.Remaining, 10))
+	ctx.Header("X-RateLimit-Reset", strconv.FormatInt(limit.Reset, 10))
+	if limit.Reached {
+		wait := max(time.Until(time.Unix(limit.Reset, 0)), time.Second)
+		ctx.Header("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+		msg := fmt.Sprintf("Too many hints, retry in %s", wait.Round(time.Second))
+		ctx.JSON(http.StatusTooManyRequests, HandleCodedError(HintRateLimited, http.StatusTooManyRequests, msg))
+		return
+	}
+
+	band := game.BandAt(hint.Distance)
+	center := s.hintArea(util.Coord{Lat: hint.Lat, Lon: hint.Lon}, eggID)
+
+	ctx.JSON(http.StatusOK, EggHintResponse{
+		InventoryID: eggID.String(),
+		Band:        band,
+		Direction:   game.CompassPoint(hint.Bearing),
+		Area: HintAreaResponse{
+			Center: center,
+			Radius: game.HintAreaRadius,
+		},
+	})
+}
+
+// hintArea returns the center of the circle of HintAreaRadius that contains
+// egg. It is offset from the egg at random, seeded with the egg and the
+// hint salt only. Every player in every band gets the same circle, so there
+// is nothing to average out or intersect, and without the salt the offset
+// cannot be recomputed.
+func (s *Server) hintArea(egg util.Coord, eggID uuid.UUID) util.Coord {
+	h := fnv.New64a()
+	h.Write([]byte(s.config.HintSalt))
+	h.Write(eggID[:])
+	rng := rand.New(rand.NewPCG(h.Sum64(), 0))
+
+	return util.Offset(egg, game.HintAreaRadius*math.Sqrt(rng.Float64()), rng.Float64()*360)
+}
@@ -0,0 +1,260 @@
+package server
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/0xdbb/eggsplore/internal/game"
+	"github.com/0xdbb/eggsplore/internal/leaderboard"
+	"github.com/0xdbb/eggsplore/util"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultLeaderboardLimit = 50
+	maxLeaderboardLimit     = 100
+
+	defaultLeaderboardNeighbours = 5
+	maxLeaderboardNeighbours     = 25
+)
+
+// Machine-readable codes of rejected leaderboard requests
+const (
+	LeaderboardNotFound = "LEADERBOARD_NOT_FOUND"
+	LeaderboardNoRegion = "LEADERBOARD_NO_REGION"
+)
+
+// LeaderboardRequest represents a page of a leaderboard
+type LeaderboardRequest struct {
+	Scope  string `form:"scope" binding:"omitempty,oneof=global weekly region"`
+	Region string `form:"region"`
+	Limit  int64  `form:"limit" binding:"omitempty,gte=1"`
+	Offset int64  `form:"offset" binding:"omitempty,gte=0"`
+}
+
+// LeaderboardAroundRequest represents the caller's place on a leaderboard
+type LeaderboardAroundRequest struct {
+	Scope      string `form:"scope" binding:"omitempty,oneof=global weekly region"`
+	Region     string `form:"region"`
+	Neighbours int64  `form:"neighbours" binding:"omitempty,gte=0"`
+}
+
+// LeaderboardEntryResponse represents a player's place on a leaderboard
+type LeaderboardEntryResponse struct {
+	Rank     int64   `json:"rank" example:"1"`
+	PlayerID string  `json:"player_id"`
+	Username string  `json:"username" example:"eggsplorer"`
+	Score    float64 `json:"score" example:"1250"`
+}
+
+// LeaderboardResponse represents a page of a leaderboard
+type LeaderboardResponse struct {
+	Board   string                     `json:"board" example:"xp"`
+	Scope   string                     `json:"scope" example:"global"`
+	Region  string                     `json:"region,omitempty" example:"Europe"`
+	Total   int64                      `json:"total"` // players on the board
+	Entries []LeaderboardEntryResponse `json:"entries"`
+}
+
+// LeaderboardAroundResponse represents the caller's place on a leaderboard
+// and the players around them
+type LeaderboardAroundResponse struct {
+	Board   string                     `json:"board" example:"xp"`
+	Scope   string                     `json:"scope" example:"global"`
+	Region  string                     `json:"region,omitempty" example:"Europe"`
+	Me      *LeaderboardEntryResponse  `json:"me"` // null when the caller is not ranked yet
+	Entries []LeaderboardEntryResponse `json:"entries"`
+}
+
+// leaderboardBoard resolves the board of a request. The region defaults to
+// the caller's own.
+func (s *Server) leaderboardBoard(ctx *gin.Context, settings []byte, scope, region string) (leaderboard.Board, bool) {
+	metric, ok := leaderboard.ParseMetric(ctx.Param("board"))
+	if !ok {
+		ctx.JSON(http.StatusNotFound, HandleCodedError(LeaderboardNotFound, http.StatusNotFound, "Leaderboard not found"))
+		return leaderboard.Board{}, false
+	}
+
+	board := leaderboard.Board{Metric: metric, Scope: leaderboard.ScopeGlobal}
+	if scope != "" {
+		board.Scope = leaderboard.Scope(scope)
+	}
+	if board.Scope == leaderboard.ScopeRegion {
+		board.Region = region
+		if board.Region == "" {
+			board.Region = game.PlayerRegion(settings)
+		}
+		if board.Region == "" {
+			ctx.JSON(http.StatusBadRequest, HandleCodedError(LeaderboardNoRegion, http.StatusBadRequest, "Set a timezone in your settings or pass a region"))
+			return leaderboard.Board{}, false
+		}
+	}
+	return board, true
+}
+
+// leaderboardEntries adds usernames to leaderboard entries
+func (s *Server) leaderboardEntries(ctx *gin.Context, entries []leaderboard.Entry) ([]LeaderboardEntryResponse, error) {
+	ids := make([]uuid.UUID, 0, len(entries))
+	for _, e := range entries {
+		if id, err := uuid.Parse(e.Member); err == nil {
+			ids = append(ids, id)
+		}
+	}
+
+	rows, err := s.db.ListPlayerUsernames(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	usernames := make(map[string]string, len(rows))
+	for _, row := range rows {
+		usernames[row.PlayerID.String()] = row.Username.String
+	}
+
+	rsp := make([]LeaderboardEntryResponse, 0, len(entries))
+	for _, e := range entries {
+		rsp = append(rsp, LeaderboardEntryResponse{
+			Rank:     e.Rank,
+			PlayerID: e.Member,
+			Username: usernames[e.Member],
+			Score:    e.Score,
+		})
+	}
+	return rsp, nil
+}
+
+// @Summary		Get Leaderboard
+// @Description	Get a page of a leaderboard, from the top. Boards rank players by xp, eggs_hatched or distance (meters travelled while reporting locations), over all time (global), this week since Monday 00:00 UTC (weekly) or all time within a region (region). Scores follow game actions within a few seconds.
+// @Tags		game
+// @Produce		json
+// @Security	BearerAuth
+// @Param		board	path		string	true	"Board (xp, eggs_hatched or distance)"
+// @Param		scope	query		string	false	"Scope (global, weekly or region, default global)"
+// @Param		region	query		string	false	"Region of a region board, e.g., Europe (defaults to the caller's)"
+// @Param		limit	query		int		false	"Page size (default 50, max 100)"
+// @Param		offset	query		int		false	"Page offset"
+// @Success		200		{object}	LeaderboardResponse
+// @Failure		400		{object}	ErrorResponse
+// @Failure		401		{object}	ErrorResponse
+// @Failure		404		{object}	ErrorResponse
+// @Failure		500		{object}	ErrorResponse
+// @Router		/game/leaderboards/{board} [get]
+func (s *Server) GetLeaderboard(ctx *gin.Context) {
+	var req LeaderboardRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		if valErr := HandleValidationError(err); valErr != nil {
+			ctx.JSON(http.StatusBadRequest, valErr)
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, HandleError(nil, http.StatusBadRequest, "Invalid request format"))
+		return
+	}
+
+	player, ok := s.currentPlayer(ctx)
+	if !ok {
+		return
+	}
+
+	board, ok := s.leaderboardBoard(ctx, player.Settings, req.Scope, req.Region)
+	if !ok {
+		return
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultLeaderboardLimit
+	}
+	limit = min(limit, maxLeaderboardLimit)
+
+	entries, total, err := s.leaderboards.Page(ctx, board, util.Now(), req.Offset, limit)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch leaderboard"))
+		return
+	}
+
+	rsp, err := s.leaderboardEntries(ctx, entries)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch leaderboard"))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, LeaderboardResponse{
+		Board:   string(board.Metric),
+		Scope:   string(board.Scope),
+		Region:  board.Region,
+		Total:   total,
+		Entries: rsp,
+	})
+}
+
+// @Summary		Get My Leaderboard Rank
+// @Description	Get the caller's rank on a leaderboard and the players ranked just above and below them
+// @Tags		game
+// @Produce		json
+// @Security	BearerAuth
+// @Param		board		path		string	true	"Board (xp, eggs_hatched or distance)"
+// @Param		scope		query		string	false	"Scope (global, weekly or region, default global)"
+// @Param		region		query		string	false	"Region of a region board, e.g., Europe (defaults to the caller's)"
+// @Param		neighbours	query		int		false	"Players to include on either side (default 5, max 25)"
+// @Success		200			{object}	LeaderboardAroundResponse
+// @Failure		400			{object}	ErrorResponse
+// @Failure		401			{object}	ErrorResponse
+// @Failure		404			{object}	ErrorResponse
+// @Failure		500			{object}	ErrorResponse
+// @Router		/game/leaderboards/{board}/me [get]
+func (s *Server) GetLeaderboardRank(ctx *gin.Context) {
+	req := LeaderboardAroundRequest{Neighbours: defaultLeaderboardNeighbours}
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		if valErr := HandleValidationError(err); valErr != nil {
+			ctx.JSON(http.StatusBadRequest, valErr)
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, HandleError(nil, http.StatusBadRequest, "Invalid request format"))
+		return
+	}
+
+	player, ok := s.currentPlayer(ctx)
+	if !ok {
+		return
+	}
+
+	board, ok := s.leaderboardBoard(ctx, player.Settings, req.Scope, req.Region)
+	if !ok {
+		return
+	}
+
+	me, entries, ranked, err := s.leaderboards.Around(ctx, board, util.Now(), player.ID, min(req.Neighbours, maxLeaderboardNeighbours))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch leaderboard"))
+		return
+	}
+
+	rsp := LeaderboardAroundResponse{
+		Board:  string(board.Metric),
+		Scope:  string(board.Scope),
+		Region: board.Region,
+	}
+	rsp.Entries, err = s.leaderboardEntries(ctx, entries)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch leaderboard"))
+		return
+	}
+	if ranked {
+		for i := range rsp.Entries {
+			if rsp.Entries[i].Rank == me.Rank {
+				rsp.Me = &rsp.Entries[i]
+			}
+		}
+	}
+
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+// FlushLeaderboards writes the leaderboard updates game actions queued every
+// LEADERBOARD_FLUSH_INTERVAL until ctx is done
+func (s *Server) FlushLeaderboards(ctx context.Context) {
+	log.Printf("Leaderboards flushed every %s", s.config.LeaderboardFlushInterval)
+	s.leaderboards.Run(ctx, s.config.LeaderboardFlushInterval)
+}
@@ -0,0 +1,103 @@
+package server
+
+import (
+	"net/http"
+	"time"
+
+	db "github.com/0xdbb/eggsplore/internal/database/sqlc"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	defaultLedgerLimit = 50
+	maxLedgerLimit     = 200
+)
+
+// LedgerRequest represents a page of a player's ledger history
+type LedgerRequest struct {
+	PlayerID string `form:"player_id"`
+	Currency string `form:"currency" binding:"omitempty,oneof=COIN XP"`
+	Limit    int32  `form:"limit" binding:"omitempty,gte=1"`
+	Offset   int32  `form:"offset" binding:"omitempty,gte=0"`
+}
+
+// LedgerEntryResponse represents a change of a player's coins or XP
+type LedgerEntryResponse struct {
+	ID            int64     `json:"id"`
+	TransactionID string    `json:"transaction_id"`
+	Currency      string    `json:"currency" example:"COIN"`
+	Amount        int64     `json:"amount" example:"-25"`
+	BalanceAfter  int64     `json:"balance_after" example:"75"`
+	Reason        string    `json:"reason" example:"EGG_DROP"`
+	ReferenceType string    `json:"reference_type" example:"egg"`
+	ReferenceID   string    `json:"reference_id"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// @Summary		Get Player Ledger
+// @Description	Get the history of a player's coin and XP changes, newest first, with the balance after each change
+// @Tags		game
+// @Produce		json
+// @Security	BearerAuth
+// @Param		player_id	query		string	false	"Player ID (defaults to the caller, must be the caller's)"
+// @Param		currency	query		string	false	"Only entries of this currency (COIN or XP)"
+// @Param		limit		query		int		false	"Page size (default 50, max 200)"
+// @Param		offset		query		int		false	"Page offset"
+// @Success		200		{array}		LedgerEntryResponse
+// @Failure		400		{object}	ErrorResponse
+// @Failure		401		{object}	ErrorResponse
+// @Failure		403		{object}	ErrorResponse
+// @Failure		500		{object}	ErrorResponse
+// @Router		/game/ledger [get]
+// @Router		/game/me/ledger [get]
+func (s *Server) GetPlayerLedger(ctx *gin.Context) {
+	var req LedgerRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		if valErr := HandleValidationError(err); valErr != nil {
+			ctx.JSON(http.StatusBadRequest, valErr)
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, HandleError(nil, http.StatusBadRequest, "Invalid request format"))
+		return
+	}
+
+	player, ok := s.requestPlayer(ctx, req.PlayerID)
+	if !ok {
+		return
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultLedgerLimit
+	}
+	limit = min(limit, maxLedgerLimit)
+
+	entries, err := s.db.ListLedgerEntriesByPlayer(ctx, db.ListLedgerEntriesByPlayerParams{
+		PlayerID:   player.ID,
+		Currency:   req.Currency,
+		PageOffset: req.Offset,
+		PageLimit:  limit,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch ledger"))
+		return
+	}
+
+	rsp := make([]LedgerEntryResponse, 0, len(entries))
+	for _, e := range entries {
+		rsp = append(rsp, LedgerEntryResponse{
+			ID:            e.ID,
+			TransactionID: e.TransactionID.String(),
+			Currency:      e.Currency,
+			Amount:        e.Amount,
+			BalanceAfter:  e.BalanceAfter,
+			Reason:        e.Reason,
+			ReferenceType: e.ReferenceType,
+			ReferenceID:   e.ReferenceID,
+			CreatedAt:     e.CreatedAt,
+		})
+	}
+
+	ctx.JSON(http.StatusOK, rsp)
+}
@@ -0,0 +1,144 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	db "github.com/0xdbb/eggsplore/internal/database/sqlc"
+	"github.com/0xdbb/eggsplore/internal/game"
+	"github.com/0xdbb/eggsplore/util"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// LocationUnknown is returned when an action needs the caller's position and
+// they have not reported a recent one
+const LocationUnknown = "LOCATION_UNKNOWN"
+
+// ReportLocationRequest represents a position reported by the caller's device
+type ReportLocationRequest struct {
+	Lat      float64  `json:"lat" binding:"required,gte=-90,lte=90"`
+	Lon      float64  `json:"lon" binding:"required,gte=-180,lte=180"`
+	Accuracy *float64 `json:"accuracy" binding:"omitempty,gte=0,lte=10000" example:"8.5"` // meters, as reported by the device
+}
+
+// LocationResponse represents a stored position of a player
+type LocationResponse struct {
+	Lat        float64    `json:"lat"`
+	Lon        float64    `json:"lon"`
+	Accuracy   *float64   `json:"accuracy,omitempty" example:"8.5"` // meters, unset when the device did not report it
+	RecordedAt time.Time  `json:"recorded_at"`
+	HoldUntil  *time.Time `json:"hold_until,omitempty"` // location based actions wait until then, when the location looked faked
+}
+
+// @Summary		Report Location
+// @Description	Report the caller's current position. Clients send it periodically while the app is open; hatching and collecting eggs check the distance from the last reported position. Distances between positions reported in a row count as travelled on the leaderboards. Positions that look faked compared to the caller's recent ones are rejected (LOCATION_REJECTED) or put the caller's location based actions on hold.
+// @Tags		game
+// @Accept		json
+// @Produce		json
+// @Security	BearerAuth
+// @Param		request	body		ReportLocationRequest	true	"Current position"
+// @Success		201		{object}	LocationResponse
+// @Failure		400		{object}	ErrorResponse
+// @Failure		401		{object}	ErrorResponse
+// @Failure		403		{object}	ErrorResponse
+// @Failure		500		{object}	ErrorResponse
+// @Router		/game/location [post]
+// @Router		/game/me/location [post]
+func (s *Server) ReportLocation(ctx *gin.Context) {
+	var req ReportLocationRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		if valErr := HandleValidationError(err); valErr != nil {
+			ctx.JSON(http.StatusBadRequest, valErr)
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, HandleError(nil, http.StatusBadRequest, "Invalid request format"))
+		return
+	}
+
+	player, ok := s.currentPlayer(ctx)
+	if !ok {
+		return
+	}
+
+	// Positions are stamped by the server, the device clock is not trusted
+	fix := game.Fix{Lat: req.Lat, Lon: req.Lon, Accuracy: -1, At: util.Now()}
+	if req.Accuracy != nil {
+		fix.Accuracy = *req.Accuracy
+	}
+	verdict, ok := s.screenLocation(ctx, player.ID, fix, sourceHeartbeat)
+	if !ok {
+		return
+	}
+
+	location, err := s.recordLocation(ctx, player.ID, fix, verdict)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to store location"))
+		return
+	}
+
+	rsp := LocationResponse{
+		Lat:        location.Lat,
+		Lon:        location.Lon,
+		RecordedAt: location.RecordedAt,
+	}
+	if location.AccuracyMeters.Valid {
+		rsp.Accuracy = &location.AccuracyMeters.Float64
+	}
+	if verdict.Action == game.ActionDelay {
+		holdUntil := fix.At.Add(verdict.Hold)
+		rsp.HoldUntil = &holdUntil
+	}
+	ctx.JSON(http.StatusCreated, rsp)
+}
+
+// recordLocation stores fix as the player's latest position. The way from
+// the previous one counts as travelled when the anti-cheat allowed the fix
+// and the previous one is recent enough to be used itself.
+func (s *Server) recordLocation(ctx *gin.Context, playerID uuid.UUID, fix game.Fix, verdict game.Verdict) (db.CreatePlayerLocationRow, error) {
+	arg := db.CreatePlayerLocationParams{
+		PlayerID:   playerID,
+		Lon:        fix.Lon,
+		Lat:        fix.Lat,
+		RecordedAt: fix.At,
+	}
+	if fix.Accuracy >= 0 {
+		arg.AccuracyMeters = pgtype.Float8{Float64: fix.Accuracy, Valid: true}
+	}
+	return s.db.RecordLocationTx(ctx, db.RecordLocationTxParams{
+		Location:  arg,
+		Travelled: verdict.Action == game.ActionAllow,
+		MaxGap:    s.config.LocationMaxAge,
+	})
+}
+
+// lastKnownPosition returns where the player last reported to be. It writes
+// the error response and returns false when they have not reported a
+// position within the configured maximum age, or their location based
+// actions are on hold.
+func (s *Server) lastKnownPosition(ctx *gin.Context, playerID uuid.UUID) (util.Coord, bool) {
+	if !s.checkLocationHold(ctx, playerID) {
+		return util.Coord{}, false
+	}
+
+	location, err := s.db.GetLastPlayerLocation(ctx, playerID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusPreconditionRequired, HandleCodedError(LocationUnknown, http.StatusPreconditionRequired, "Report your location first"))
+			return util.Coord{}, false
+		}
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch location"))
+		return util.Coord{}, false
+	}
+
+	if age := util.Now().Sub(location.RecordedAt); age > s.config.LocationMaxAge {
+		msg := fmt.Sprintf("Last location is %s old, report your location again", age.Round(time.Second))
+		ctx.JSON(http.StatusPreconditionRequired, HandleCodedError(LocationUnknown, http.StatusPreconditionRequired, msg))
+		return util.Coord{}, false
+	}
+	return util.Coord{Lat: location.Lat, Lon: location.Lon}, true
+}
@@ -8,6 +8,9 @@ import (
 	"net/http"
 	"strings"
 
+	db "github.com/0xdbb/eggsplore/internal/database/sqlc"
+	"github.com/0xdbb/eggsplore/util"
+
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 )
@@ -70,7 +73,8 @@ func ExtractTokenPayload(ctx *gin.Context) (*token.Payload, error) {
 	return payload, nil
 }
 
-// UpdateLastActiveMiddleware updates the last_active and updated_at fields for the authenticated account.
+// UpdateLastActiveMiddleware updates the last_active and updated_at fields for the authenticated account
+// and counts the day towards the player's login streak.
 func (s *Server) UpdateLastActiveMiddleware() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		// Extract payload from context
@@ -103,6 +107,30 @@ func (s *Server) UpdateLastActiveMiddleware() gin.HandlerFunc {
 			return
 		}
 
+		// Streaks are secondary to the request, accounts without a player have none
+		if _, err := s.db.RecordActivityTx(ctx, tokenPayload.AccountID, util.Now()); err != nil && !errors.Is(err, db.ErrRecordNotFound) {
+			log.Printf("⚠️ Failed to record activity for account %s: %v", tokenPayload.AccountID, err)
+		}
+
+		ctx.Next()
+	}
+}
+
+// RequireRole aborts requests whose token does not carry the given role.
+// It must run after AuthMiddleware.
+func RequireRole(role string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		payload, ok := authPayload(ctx)
+		if !ok {
+			ctx.Abort()
+			return
+		}
+
+		if payload.Role != role {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, HandleError(nil, http.StatusForbidden, "Requires "+role+" role"))
+			return
+		}
+
 		ctx.Next()
 	}
 }
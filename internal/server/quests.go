@@ -0,0 +1,170 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	db "github.com/0xdbb/eggsplore/internal/database/sqlc"
+	"github.com/0xdbb/eggsplore/util"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Machine-readable codes of rejected quest claims
+const (
+	QuestNotFound       = "QUEST_NOT_FOUND"
+	QuestNotCompleted   = "QUEST_NOT_COMPLETED"
+	QuestAlreadyClaimed = "QUEST_ALREADY_CLAIMED"
+)
+
+// QuestResponse represents one of the caller's current quests
+type QuestResponse struct {
+	Code        string     `json:"code" example:"DAILY_HATCH_3"`
+	Name        string     `json:"name" example:"Busy Nest"`
+	Description string     `json:"description" example:"Hatch 3 eggs."`
+	Period      string     `json:"period" example:"DAILY"`
+	Event       string     `json:"event" example:"EGG_HATCHED"`
+	EggType     string     `json:"egg_type,omitempty"`
+	Target      int64      `json:"target" example:"3"`
+	Progress    int64      `json:"progress" example:"1"` // capped at the target
+	RewardCoins int64      `json:"reward_coins" example:"50"`
+	RewardXp    int64      `json:"reward_xp" example:"50"`
+	Completed   bool       `json:"completed"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	Claimed     bool       `json:"claimed"`
+	ClaimedAt   *time.Time `json:"claimed_at,omitempty"`
+	ResetsAt    time.Time  `json:"resets_at"` // local midnight the quest is replaced at
+}
+
+// ClaimQuestResponse represents a paid quest reward
+type ClaimQuestResponse struct {
+	Quest    QuestResponse     `json:"quest"`
+	Coins    int64             `json:"coins"` // player's balance after the reward
+	Xp       int64             `json:"xp"`
+	Level    int32             `json:"level"`
+	LevelUps []LevelUpResponse `json:"level_ups"`
+}
+
+// @Summary		List Quests
+// @Description	List the caller's daily and weekly quests with their progress. Quests are picked per player and reset at local midnight in the time zone set in the player's settings (UTC by default); weekly quests reset on Monday.
+// @Tags		game
+// @Produce		json
+// @Security	BearerAuth
+// @Success		200		{array}		QuestResponse
+// @Failure		401		{object}	ErrorResponse
+// @Failure		500		{object}	ErrorResponse
+// @Router		/game/quests [get]
+// @Router		/game/me/quests [get]
+func (s *Server) ListQuests(ctx *gin.Context) {
+	player, ok := s.currentPlayer(ctx)
+	if !ok {
+		return
+	}
+
+	active, err := s.db.ActiveQuests(ctx, player, util.Now())
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch quests"))
+		return
+	}
+
+	dates := make([]pgtype.Date, 0, len(active))
+	for _, quest := range active {
+		dates = append(dates, quest.Date())
+	}
+	rows, err := s.db.ListPlayerQuests(ctx, db.ListPlayerQuestsParams{
+		PlayerID:     player.ID,
+		PeriodStarts: dates,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch quest progress"))
+		return
+	}
+
+	type questKey struct {
+		code string
+		date string
+	}
+	progress := make(map[questKey]db.PlayerQuests, len(rows))
+	for _, r := range rows {
+		progress[questKey{r.TemplateCode, r.PeriodStart.Time.Format(time.DateOnly)}] = r
+	}
+
+	rsp := make([]QuestResponse, 0, len(active))
+	for _, quest := range active {
+		rsp = append(rsp, questResponse(quest, progress[questKey{quest.Template.Code, quest.PeriodStart.Format(time.DateOnly)}]))
+	}
+
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+// @Summary		Claim Quest
+// @Description	Claim the coin and XP reward of a completed quest of the current day or week. Each reward can be claimed once per period.
+// @Description	Rejections carry an error_code: QUEST_NOT_FOUND, QUEST_NOT_COMPLETED or QUEST_ALREADY_CLAIMED.
+// @Tags		game
+// @Produce		json
+// @Security	BearerAuth
+// @Param		code	path		string	true	"Quest code"
+// @Success		200		{object}	ClaimQuestResponse
+// @Failure		401		{object}	ErrorResponse
+// @Failure		403		{object}	ErrorResponse
+// @Failure		404		{object}	ErrorResponse
+// @Failure		409		{object}	ErrorResponse
+// @Failure		500		{object}	ErrorResponse
+// @Router		/game/quests/{code}/claim [post]
+// @Router		/game/me/quests/{code}/claim [post]
+func (s *Server) ClaimQuest(ctx *gin.Context) {
+	player, ok := s.currentPlayer(ctx)
+	if !ok {
+		return
+	}
+
+	result, err := s.db.ClaimQuestTx(ctx, db.ClaimQuestTxParams{
+		PlayerID: player.ID,
+		Code:     ctx.Param("code"),
+		Now:      util.Now(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, db.ErrRecordNotFound):
+			ctx.JSON(http.StatusNotFound, HandleCodedError(QuestNotFound, http.StatusNotFound, "Quest is not one of your current quests"))
+		case errors.Is(err, db.ErrNotCompleted):
+			ctx.JSON(http.StatusForbidden, HandleCodedError(QuestNotCompleted, http.StatusForbidden, "Quest is not completed yet"))
+		case errors.Is(err, db.ErrAlreadyClaimed):
+			ctx.JSON(http.StatusConflict, HandleCodedError(QuestAlreadyClaimed, http.StatusConflict, "Quest reward was already claimed"))
+		default:
+			ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to claim quest"))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ClaimQuestResponse{
+		Quest:    questResponse(result.Quest, result.Progress),
+		Coins:    result.Player.Coins,
+		Xp:       result.Player.Xp,
+		Level:    result.Player.Level,
+		LevelUps: levelUpResponses(result.LevelUps),
+	})
+}
+
+func questResponse(quest db.ActiveQuest, progress db.PlayerQuests) QuestResponse {
+	t := quest.Template
+	return QuestResponse{
+		Code:        t.Code,
+		Name:        t.Name,
+		Description: t.Description,
+		Period:      t.Period,
+		Event:       t.Event,
+		EggType:     t.EggType.String,
+		Target:      t.Target,
+		Progress:    min(progress.Progress, t.Target),
+		RewardCoins: t.RewardCoins,
+		RewardXp:    t.RewardXp,
+		Completed:   progress.CompletedAt.Valid,
+		CompletedAt: timestamptzToTime(progress.CompletedAt),
+		Claimed:     progress.ClaimedAt.Valid,
+		ClaimedAt:   timestamptzToTime(progress.ClaimedAt),
+		ResetsAt:    quest.ResetsAt,
+	}
+}
@@ -33,6 +33,7 @@ func (s *Server) RegisterRoutes() {
 		s.swaggerRoute(api)
 		s.authRoutes(api)
 		s.gameRoutes(api)
+		s.adminRoutes(api)
 		api.GET("/health", func(c *gin.Context) {
 			c.JSON(http.StatusOK, gin.H{"status": "up"})
 		})
@@ -52,6 +53,7 @@ func (s *Server) Cors() {
 			"Origin",
 			"Content-Type",
 			"Authorization",
+			"Idempotency-Key",
 		},
 		ExposeHeaders:    []string{"Content-Length"},
 		AllowCredentials: true,
@@ -73,12 +75,80 @@ func (s *Server) authRoutes(group *gin.RouterGroup) {
 
 func (s *Server) gameRoutes(group *gin.RouterGroup) {
 	game := group.Group("/game")
+	game.Use(AuthMiddleware(s.tokenMaker), s.UpdateLastActiveMiddleware())
 	{
 		game.GET("/eggs", s.GetPlayerEggs)
 		game.POST("/eggs", s.DropEgg)
+		game.GET("/eggs/nearby", s.GetNearbyEggs)
+		game.GET("/eggs/clusters", s.GetEggClusters)
+		game.GET("/eggs/tiles/:z/:x/:y", s.GetEggTile)
+		game.GET("/spawns/nearby", s.GetNearbySpawns)
+		game.POST("/spawns/:id/claim", s.ClaimSpawn)
+		game.POST("/location", s.ReportLocation)
+		game.GET("/egg-types", s.ListEggTypes)
+		game.GET("/shop", s.ListShopItems)
+		game.POST("/shop/purchase", s.PurchaseShopItem)
+		game.POST("/eggs/:id/hatch", s.HatchEgg)
+		game.POST("/eggs/:id/collect", s.CollectEgg)
+		game.GET("/eggs/:id/hint", s.GetEggHint)
 		game.GET("/inventory", s.GetPlayerInventory)
 		game.GET("/player", s.GetPlayerStats)
 		game.GET("/tools", s.GetPlayerTools)
+		game.POST("/tools/:id/equip", s.EquipTool)
+		game.POST("/tools/:id/unequip", s.UnequipTool)
+		game.GET("/boosts", s.GetPlayerBoosts)
+		game.POST("/boosts/:id/activate", s.ActivateBoost)
+		game.GET("/ledger", s.GetPlayerLedger)
+		game.GET("/achievements", s.ListAchievements)
+		game.POST("/achievements/:code/claim", s.ClaimAchievement)
+		game.GET("/quests", s.ListQuests)
+		game.POST("/quests/:code/claim", s.ClaimQuest)
+		game.GET("/streak", s.GetLoginStreak)
+		game.POST("/streak/claim", s.ClaimDailyReward)
+		game.GET("/leaderboards/:board", s.GetLeaderboard)
+		game.GET("/leaderboards/:board/me", s.GetLeaderboardRank)
+		game.GET("/creatures", s.GetPlayerCreatures)
+		game.GET("/dex", s.GetPlayerDex)
+	}
+
+	// Same handlers, always resolved to the caller's player
+	me := game.Group("/me")
+	{
+		me.GET("", s.GetPlayerStats)
+		me.GET("/eggs", s.GetPlayerEggs)
+		me.POST("/eggs", s.DropEgg)
+		me.POST("/location", s.ReportLocation)
+		me.GET("/inventory", s.GetPlayerInventory)
+		me.GET("/tools", s.GetPlayerTools)
+		me.POST("/tools/:id/equip", s.EquipTool)
+		me.POST("/tools/:id/unequip", s.UnequipTool)
+		me.GET("/boosts", s.GetPlayerBoosts)
+		me.POST("/boosts/:id/activate", s.ActivateBoost)
+		me.GET("/ledger", s.GetPlayerLedger)
+		me.GET("/achievements", s.ListAchievements)
+		me.POST("/achievements/:code/claim", s.ClaimAchievement)
+		me.GET("/quests", s.ListQuests)
+		me.POST("/quests/:code/claim", s.ClaimQuest)
+		me.GET("/streak", s.GetLoginStreak)
+		me.POST("/streak/claim", s.ClaimDailyReward)
+		me.GET("/creatures", s.GetPlayerCreatures)
+		me.GET("/dex", s.GetPlayerDex)
+	}
+}
+
+func (s *Server) adminRoutes(group *gin.RouterGroup) {
+	admin := group.Group("/admin")
+	admin.Use(AuthMiddleware(s.tokenMaker), RequireRole("ADMIN"))
+	{
+		admin.GET("/zones", s.ListExclusionZones)
+		admin.POST("/zones", s.CreateExclusionZone)
+		admin.POST("/zones/import", s.ImportExclusionZones)
+		admin.DELETE("/zones/:id", s.DeleteExclusionZone)
+		admin.GET("/spawn-points", s.ListSpawnPoints)
+		admin.POST("/spawn-points", s.CreateSpawnPoint)
+		admin.DELETE("/spawn-points/:id", s.DeleteSpawnPoint)
+		admin.GET("/incidents", s.ListLocationIncidents)
+		admin.POST("/incidents/:id/review", s.ReviewLocationIncident)
 	}
 }
 
@@ -1,18 +1,26 @@
 package server
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"net/http"
 	"time"
 
 	"github.com/0xdbb/eggsplore/internal/config"
+	"github.com/0xdbb/eggsplore/internal/leaderboard"
 	"github.com/0xdbb/eggsplore/token"
+	"github.com/0xdbb/eggsplore/util"
 
 	db "github.com/0xdbb/eggsplore/internal/database/sqlc"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
 	"github.com/go-playground/validator/v10"
+	"github.com/redis/go-redis/v9"
+	"github.com/ulule/limiter/v3"
+	memorystore "github.com/ulule/limiter/v3/drivers/store/memory"
+	redisstore "github.com/ulule/limiter/v3/drivers/store/redis"
 )
 
 type Server struct {
@@ -21,6 +29,10 @@ type Server struct {
 	tokenMaker token.Maker
 	db         *db.Service
 	config     *config.Config
+
+	leaderboards *leaderboard.Leaderboards
+	hintLimiter  *limiter.Limiter
+	tiles        *tileCache
 }
 
 func NewServer(appConfig *config.Config) (*Server, *http.Server, error) {
@@ -39,13 +51,56 @@ func NewServer(appConfig *config.Config) (*Server, *http.Server, error) {
 
 	// Database service
 	newService := db.NewService(appConfig.DbUrl)
+	newService.SetXPCurve(appConfig.XPCurve())
+
+	// One Redis client shared by everything kept there, when it is configured
+	var redisClient *redis.Client
+	if appConfig.RedisUrl != "" {
+		opt, err := redis.ParseURL(appConfig.RedisUrl)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error creating redis client: %w", err)
+		}
+		redisClient = redis.NewClient(opt)
+	}
+
+	// Leaderboards, in Redis when it is configured
+	var store leaderboard.Store
+	if redisClient != nil {
+		store = leaderboard.NewRedisStore(redisClient)
+	} else {
+		log.Printf("⚠️  No REDIS_URL set, keeping leaderboards in memory")
+		store = leaderboard.NewMemoryStore()
+	}
+	leaderboards := leaderboard.New(store, newService)
+	if err := leaderboards.Rebuild(context.Background(), util.Now()); err != nil {
+		log.Printf("⚠️ Failed to rebuild leaderboards: %v", err)
+	}
+
+	// Hint rate limit, shared through Redis when it is configured
+	hintRate, err := limiter.NewRateFromFormatted(appConfig.HintRateLimit)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid HINT_RATE_LIMIT: %w", err)
+	}
+	limitOptions := limiter.StoreOptions{Prefix: "limiter:hint"}
+	var limitStore limiter.Store
+	if redisClient != nil {
+		limitStore, err = redisstore.NewStoreWithOptions(redisClient, limitOptions)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error creating rate limit store: %w", err)
+		}
+	} else {
+		limitStore = memorystore.NewStoreWithOptions(limitOptions)
+	}
 
 	// Build our Server struct
 	appServer := &Server{
-		engine:     gin.Default(),
-		config:     appConfig,
-		tokenMaker: tokenMaker,
-		db:         newService,
+		engine:       gin.Default(),
+		config:       appConfig,
+		tokenMaker:   tokenMaker,
+		db:           newService,
+		leaderboards: leaderboards,
+		hintLimiter:  limiter.New(limitStore, hintRate),
+		tiles:        newTileCache(appConfig.TileCacheTTL),
 	}
 
 	// Register custom validators
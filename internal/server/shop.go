@@ -0,0 +1,200 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	db "github.com/0xdbb/eggsplore/internal/database/sqlc"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Machine-readable codes of rejected purchases
+const (
+	ShopItemNotFound      = "SHOP_ITEM_NOT_FOUND"
+	ShopLevelTooLow       = "SHOP_LEVEL_TOO_LOW"
+	ShopInsufficientCoins = "SHOP_INSUFFICIENT_COINS"
+	ShopAlreadyUnlocked   = "SHOP_ALREADY_UNLOCKED"
+	ShopRequestKeyReused  = "SHOP_REQUEST_KEY_REUSED"
+)
+
+const idempotencyKeyHeader = "Idempotency-Key"
+
+// ShopItemResponse represents an item for sale
+type ShopItemResponse struct {
+	Code                 string  `json:"code" example:"DOUBLE_XP"`
+	Name                 string  `json:"name" example:"Double XP"`
+	Description          string  `json:"description"`
+	ItemType             string  `json:"item_type" example:"BOOST"`
+	Price                int64   `json:"price" example:"50"`
+	Quantity             int32   `json:"quantity" example:"1"` // units per pack
+	MinLevel             int32   `json:"min_level"`
+	ToolKind             string  `json:"tool_kind,omitempty" example:"RADAR"`
+	BoostKind            string  `json:"boost_kind,omitempty" example:"XP_MULTIPLIER"`
+	BoostMultiplier      float64 `json:"boost_multiplier,omitempty" example:"2"`
+	BoostDurationSeconds int32   `json:"boost_duration_seconds,omitempty" example:"1800"`
+	EggType              string  `json:"egg_type,omitempty" example:"LEGENDARY"`
+}
+
+// PurchaseRequest represents buying packs of a shop item. The request key
+// can also be sent as the Idempotency-Key header.
+type PurchaseRequest struct {
+	ItemCode   string `json:"item_code" binding:"required" example:"DOUBLE_XP"`
+	Packs      int32  `json:"packs" binding:"omitempty,gte=1,lte=10" example:"1"`
+	RequestKey string `json:"request_key" binding:"omitempty,max=100" example:"3f2b6c1e-purchase-1"`
+}
+
+// PurchaseResponse represents a completed purchase
+type PurchaseResponse struct {
+	PurchaseID   string    `json:"purchase_id"`
+	ItemCode     string    `json:"item_code"`
+	Packs        int32     `json:"packs"`
+	Price        int64     `json:"price"` // total charged
+	Coins        int64     `json:"coins"` // player's balance after the purchase
+	InventoryIDs []string  `json:"inventory_ids"`
+	Replayed     bool      `json:"replayed"` // the request key was used before, nothing new was charged
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// @Summary		List Shop Items
+// @Description	List the tools, boosts and egg type unlocks for sale
+// @Tags		game
+// @Produce		json
+// @Security	BearerAuth
+// @Success		200		{array}		ShopItemResponse
+// @Failure		401		{object}	ErrorResponse
+// @Failure		500		{object}	ErrorResponse
+// @Router		/game/shop [get]
+func (s *Server) ListShopItems(ctx *gin.Context) {
+	items, err := s.db.ListShopItems(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch shop items"))
+		return
+	}
+
+	rsp := make([]ShopItemResponse, 0, len(items))
+	for _, item := range items {
+		rsp = append(rsp, ShopItemResponse{
+			Code:                 item.Code,
+			Name:                 item.Name,
+			Description:          item.Description,
+			ItemType:             item.ItemType,
+			Price:                item.Price,
+			Quantity:             item.Quantity,
+			MinLevel:             item.MinLevel,
+			ToolKind:             item.ToolKind.String,
+			BoostKind:            item.BoostKind.String,
+			BoostMultiplier:      item.BoostMultiplier.Float64,
+			BoostDurationSeconds: item.BoostDurationSeconds.Int32,
+			EggType:              item.EggType.String,
+		})
+	}
+
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+// @Summary		Purchase Shop Item
+// @Description	Buy packs of a shop item with coins. Coins are debited and the items created atomically.
+// @Description	Retrying with the same request key returns the original purchase instead of charging again.
+// @Description	Rejections carry an error_code: SHOP_ITEM_NOT_FOUND, SHOP_LEVEL_TOO_LOW, SHOP_INSUFFICIENT_COINS, SHOP_ALREADY_UNLOCKED or SHOP_REQUEST_KEY_REUSED.
+// @Tags		game
+// @Accept		json
+// @Produce		json
+// @Security	BearerAuth
+// @Param		Idempotency-Key	header		string				false	"Request key, if not in the body"
+// @Param		request			body		PurchaseRequest		true	"Purchase"
+// @Success		200				{object}	PurchaseResponse
+// @Failure		400				{object}	ErrorResponse
+// @Failure		401				{object}	ErrorResponse
+// @Failure		402				{object}	ErrorResponse
+// @Failure		403				{object}	ErrorResponse
+// @Failure		404				{object}	ErrorResponse
+// @Failure		409				{object}	ErrorResponse
+// @Failure		500				{object}	ErrorResponse
+// @Router		/game/shop/purchase [post]
+func (s *Server) PurchaseShopItem(ctx *gin.Context) {
+	var req PurchaseRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		if valErr := HandleValidationError(err); valErr != nil {
+			ctx.JSON(http.StatusBadRequest, valErr)
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, HandleError(nil, http.StatusBadRequest, "Invalid request format"))
+		return
+	}
+
+	requestKey := fallback(req.RequestKey, ctx.GetHeader(idempotencyKeyHeader))
+	if requestKey == "" || len(requestKey) > 100 {
+		ctx.JSON(http.StatusBadRequest, HandleError(nil, http.StatusBadRequest, "A request_key or Idempotency-Key header of at most 100 characters is required"))
+		return
+	}
+	packs := max(req.Packs, 1)
+
+	player, ok := s.currentPlayer(ctx)
+	if !ok {
+		return
+	}
+
+	item, err := s.db.GetShopItemByCode(ctx, req.ItemCode)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, HandleCodedError(ShopItemNotFound, http.StatusNotFound, "Shop item not found"))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch shop item"))
+		return
+	}
+	if !item.Enabled {
+		ctx.JSON(http.StatusNotFound, HandleCodedError(ShopItemNotFound, http.StatusNotFound, "Shop item is not for sale"))
+		return
+	}
+	if item.ItemType == "EGG_TYPE" && packs > 1 {
+		ctx.JSON(http.StatusBadRequest, HandleError(nil, http.StatusBadRequest, "Egg type unlocks are bought one at a time"))
+		return
+	}
+	if player.Level < item.MinLevel {
+		ctx.JSON(http.StatusForbidden, HandleCodedError(ShopLevelTooLow, http.StatusForbidden, fmt.Sprintf("Requires level %d, you are level %d", item.MinLevel, player.Level)))
+		return
+	}
+
+	result, err := s.db.PurchaseItemTx(ctx, db.PurchaseItemTxParams{
+		PlayerID:   player.ID,
+		Item:       item,
+		Packs:      packs,
+		RequestKey: requestKey,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, db.ErrInsufficientCoins):
+			ctx.JSON(http.StatusPaymentRequired, HandleCodedError(ShopInsufficientCoins, http.StatusPaymentRequired, fmt.Sprintf("Costs %d coins, you have %d", item.Price*int64(packs), player.Coins)))
+		case errors.Is(err, db.ErrAlreadyUnlocked):
+			ctx.JSON(http.StatusConflict, HandleCodedError(ShopAlreadyUnlocked, http.StatusConflict, "You already unlocked this egg type"))
+		case errors.Is(err, db.ErrRequestKeyReused):
+			ctx.JSON(http.StatusConflict, HandleCodedError(ShopRequestKeyReused, http.StatusConflict, "Request key was already used for a different purchase"))
+		case errors.Is(err, db.ErrRecordNotFound):
+			ctx.JSON(http.StatusNotFound, HandleError(nil, http.StatusNotFound, "Player not found"))
+		default:
+			ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to complete purchase"))
+		}
+		return
+	}
+
+	purchase := result.Purchase
+	inventoryIDs := make([]string, 0, len(purchase.InventoryIds))
+	for _, id := range purchase.InventoryIds {
+		inventoryIDs = append(inventoryIDs, id.String())
+	}
+
+	ctx.JSON(http.StatusOK, PurchaseResponse{
+		PurchaseID:   purchase.ID.String(),
+		ItemCode:     item.Code,
+		Packs:        purchase.Packs,
+		Price:        purchase.Price,
+		Coins:        result.Player.Coins,
+		InventoryIDs: inventoryIDs,
+		Replayed:     result.Replayed,
+		CreatedAt:    purchase.CreatedAt,
+	})
+}
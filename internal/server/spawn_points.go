@@ -0,0 +1,165 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	db "github.com/0xdbb/eggsplore/internal/database/sqlc"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	defaultSpawnPointRadius    = 200 // meters
+	maxSpawnPointRadius        = 5000
+	defaultSpawnPointMaxActive = 5
+)
+
+// CreateSpawnPointRequest represents a place the spawner keeps eggs around
+type CreateSpawnPointRequest struct {
+	Name         string  `json:"name" binding:"required" example:"Central Park Fountain"`
+	Lat          float64 `json:"lat" binding:"required,gte=-90,lte=90"`
+	Lon          float64 `json:"lon" binding:"required,gte=-180,lte=180"`
+	RadiusMeters float64 `json:"radius_meters" binding:"omitempty,gt=0" example:"200"` // default 200, max 5000
+	MaxActive    int32   `json:"max_active" binding:"omitempty,gte=1" example:"5"`     // default 5
+	Enabled      *bool   `json:"enabled"`                                              // default true
+}
+
+// SpawnPointResponse represents a spawn point
+type SpawnPointResponse struct {
+	ID           int32     `json:"id"`
+	Name         string    `json:"name"`
+	Lat          float64   `json:"lat"`
+	Lon          float64   `json:"lon"`
+	RadiusMeters float64   `json:"radius_meters"`
+	MaxActive    int32     `json:"max_active"` // spawns kept within the radius at most
+	Enabled      bool      `json:"enabled"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// @Summary		Create Spawn Point
+// @Description	Create a place the world spawner keeps up to max_active eggs around, within radius_meters. Spawns inside exclusion zones are still skipped.
+// @Tags		admin
+// @Accept		json
+// @Produce		json
+// @Security	BearerAuth
+// @Param		request	body		CreateSpawnPointRequest	true	"Spawn point"
+// @Success		201		{object}	SpawnPointResponse
+// @Failure		400		{object}	ErrorResponse
+// @Failure		401		{object}	ErrorResponse
+// @Failure		403		{object}	ErrorResponse
+// @Failure		500		{object}	ErrorResponse
+// @Router		/admin/spawn-points [post]
+func (s *Server) CreateSpawnPoint(ctx *gin.Context) {
+	var req CreateSpawnPointRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		if valErr := HandleValidationError(err); valErr != nil {
+			ctx.JSON(http.StatusBadRequest, valErr)
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, HandleError(nil, http.StatusBadRequest, "Invalid request format"))
+		return
+	}
+
+	radius := req.RadiusMeters
+	if radius == 0 {
+		radius = defaultSpawnPointRadius
+	}
+	radius = min(radius, maxSpawnPointRadius)
+
+	maxActive := req.MaxActive
+	if maxActive == 0 {
+		maxActive = defaultSpawnPointMaxActive
+	}
+
+	enabled := true
+	if req.Enabled != nil {
+		enabled = *req.Enabled
+	}
+
+	point, err := s.db.CreateSpawnPoint(ctx, db.CreateSpawnPointParams{
+		Name:         req.Name,
+		Lon:          req.Lon,
+		Lat:          req.Lat,
+		RadiusMeters: radius,
+		MaxActive:    maxActive,
+		Enabled:      enabled,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to create spawn point"))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, spawnPointFromRow(db.ListAllSpawnPointsRow(point)))
+}
+
+// @Summary		List Spawn Points
+// @Description	List all spawn points, including disabled ones
+// @Tags		admin
+// @Produce		json
+// @Security	BearerAuth
+// @Success		200		{array}		SpawnPointResponse
+// @Failure		401		{object}	ErrorResponse
+// @Failure		403		{object}	ErrorResponse
+// @Failure		500		{object}	ErrorResponse
+// @Router		/admin/spawn-points [get]
+func (s *Server) ListSpawnPoints(ctx *gin.Context) {
+	points, err := s.db.ListAllSpawnPoints(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch spawn points"))
+		return
+	}
+
+	rsp := make([]SpawnPointResponse, 0, len(points))
+	for _, p := range points {
+		rsp = append(rsp, spawnPointFromRow(p))
+	}
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+// @Summary		Delete Spawn Point
+// @Description	Delete a spawn point. Eggs it already spawned stay until they are collected or expire.
+// @Tags		admin
+// @Produce		json
+// @Security	BearerAuth
+// @Param		id	path		int	true	"Spawn point ID"
+// @Success		200	{object}	UserMessage
+// @Failure		400	{object}	ErrorResponse
+// @Failure		401	{object}	ErrorResponse
+// @Failure		403	{object}	ErrorResponse
+// @Failure		404	{object}	ErrorResponse
+// @Failure		500	{object}	ErrorResponse
+// @Router		/admin/spawn-points/{id} [delete]
+func (s *Server) DeleteSpawnPoint(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, HandleError(nil, http.StatusBadRequest, "Invalid spawn point id format"))
+		return
+	}
+
+	deleted, err := s.db.DeleteSpawnPoint(ctx, int32(id))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to delete spawn point"))
+		return
+	}
+	if deleted == 0 {
+		ctx.JSON(http.StatusNotFound, HandleError(nil, http.StatusNotFound, "Spawn point not found"))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, HandleMessage("Spawn point deleted"))
+}
+
+func spawnPointFromRow(p db.ListAllSpawnPointsRow) SpawnPointResponse {
+	return SpawnPointResponse{
+		ID:           p.ID,
+		Name:         p.Name,
+		Lat:          p.Lat,
+		Lon:          p.Lon,
+		RadiusMeters: p.RadiusMeters,
+		MaxActive:    p.MaxActive,
+		Enabled:      p.Enabled,
+		CreatedAt:    p.CreatedAt,
+	}
+}
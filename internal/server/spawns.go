@@ -0,0 +1,226 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	db "github.com/0xdbb/eggsplore/internal/database/sqlc"
+	"github.com/0xdbb/eggsplore/internal/game"
+	"github.com/0xdbb/eggsplore/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NearbySpawnsRequest represents a search for spawned eggs around the caller
+type NearbySpawnsRequest struct {
+	Lat    float64 `form:"lat" binding:"required,gte=-90,lte=90"`
+	Lon    float64 `form:"lon" binding:"required,gte=-180,lte=180"`
+	Radius float64 `form:"radius" binding:"omitempty,gt=0"`
+	Limit  int32   `form:"limit" binding:"omitempty,gte=1"`
+	Offset int32   `form:"offset" binding:"omitempty,gte=0"`
+}
+
+// NearbySpawnResponse represents a server-owned egg waiting to be collected
+type NearbySpawnResponse struct {
+	ID        string    `json:"id"`
+	Type      string    `json:"type" example:"BUNNY"`
+	Lat       float64   `json:"lat"`
+	Lon       float64   `json:"lon"`
+	Distance  float64   `json:"distance" example:"42.5"` // meters
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// @Summary		Get Nearby Spawns
+// @Description	Get eggs placed by the world spawner around a position, sorted by distance. Spawns belong to no player and disappear when nobody collects them in time.
+// @Tags		game
+// @Produce		json
+// @Security	BearerAuth
+// @Param		lat		query		number	true	"Latitude of the caller"
+// @Param		lon		query		number	true	"Longitude of the caller"
+// @Param		radius	query		number	false	"Search radius in meters (default 500, max 5000)"
+// @Param		limit	query		int		false	"Page size (default 50, max 200)"
+// @Param		offset	query		int		false	"Page offset"
+// @Success		200		{array}		NearbySpawnResponse
+// @Failure		400		{object}	ErrorResponse
+// @Failure		401		{object}	ErrorResponse
+// @Failure		500		{object}	ErrorResponse
+// @Router		/game/spawns/nearby [get]
+func (s *Server) GetNearbySpawns(ctx *gin.Context) {
+	var req NearbySpawnsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		if valErr := HandleValidationError(err); valErr != nil {
+			ctx.JSON(http.StatusBadRequest, valErr)
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, HandleError(nil, http.StatusBadRequest, "Invalid request format"))
+		return
+	}
+
+	radius := req.Radius
+	if radius == 0 {
+		radius = defaultNearbyRadius
+	}
+	radius = min(radius, maxNearbyRadius)
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultNearbyLimit
+	}
+	limit = min(limit, maxNearbyLimit)
+
+	spawns, err := s.db.ListSpawnsWithinRadius(ctx, db.ListSpawnsWithinRadiusParams{
+		Lon:        req.Lon,
+		Lat:        req.Lat,
+		Radius:     radius,
+		PageLimit:  limit,
+		PageOffset: req.Offset,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch nearby spawns"))
+		return
+	}
+
+	rsp := make([]NearbySpawnResponse, 0, len(spawns))
+	for _, spawn := range spawns {
+		rsp = append(rsp, NearbySpawnResponse{
+			ID:        spawn.ID.String(),
+			Type:      spawn.EggType,
+			Lat:       spawn.Lat,
+			Lon:       spawn.Lon,
+			Distance:  spawn.Distance,
+			ExpiresAt: spawn.ExpiresAt,
+		})
+	}
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+// ClaimSpawnResponse represents a spawned egg picked up into the caller's inventory
+type ClaimSpawnResponse struct {
+	InventoryID string            `json:"inventory_id"`
+	Type        string            `json:"type"`
+	State       string            `json:"state" example:"PLANTED"`
+	ReadyAt     time.Time         `json:"ready_at"`
+	DecaysAt    time.Time         `json:"decays_at"`
+	CollectedAt time.Time         `json:"collected_at"`
+	Reward      game.EggReward    `json:"reward"`
+	Coins       int64             `json:"coins"` // caller's balance after the reward
+	Xp          int64             `json:"xp"`
+	Level       int32             `json:"level"`
+	LevelUps    []LevelUpResponse `json:"level_ups"`
+	GoalUpdatesResponse
+}
+
+// @Summary		Claim Spawn
+// @Description	Pick up an egg the world spawner placed. The caller's last reported location must be within the configured hatch distance of the spawn. The spawn becomes an egg in the caller's inventory that starts incubating on pickup and can be hatched anywhere; the caller earns half of the egg type's hatch reward.
+// @Tags		game
+// @Produce		json
+// @Security	BearerAuth
+// @Param		id		path		string	true	"Spawn ID"
+// @Success		200		{object}	ClaimSpawnResponse
+// @Failure		400		{object}	ErrorResponse
+// @Failure		401		{object}	ErrorResponse
+// @Failure		403		{object}	ErrorResponse
+// @Failure		404		{object}	ErrorResponse
+// @Failure		409		{object}	ErrorResponse
+// @Failure		428		{object}	ErrorResponse
+// @Failure		429		{object}	ErrorResponse
+// @Failure		500		{object}	ErrorResponse
+// @Router		/game/spawns/{id}/claim [post]
+func (s *Server) ClaimSpawn(ctx *gin.Context) {
+	spawnID, ok := parseUUID(ctx, ctx.Param("id"), "spawn id")
+	if !ok {
+		return
+	}
+
+	player, ok := s.currentPlayer(ctx)
+	if !ok {
+		return
+	}
+
+	position, ok := s.lastKnownPosition(ctx, player.ID)
+	if !ok {
+		return
+	}
+
+	spawn, err := s.db.GetSpawn(ctx, spawnID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, HandleError(nil, http.StatusNotFound, "Spawn not found"))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch spawn"))
+		return
+	}
+
+	eggType, err := s.db.GetEggType(ctx, spawn.EggType)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch egg type"))
+		return
+	}
+
+	boosts, err := s.activeBoosts(ctx, player.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch active boosts"))
+		return
+	}
+
+	reward := game.FinderReward(eggReward(eggType)).Apply(boosts)
+
+	// The egg starts its lifecycle when it is picked up. The rules run
+	// against the locked spawn, so a concurrent claimer sees it collected
+	// once this transaction commits.
+	now := util.Now()
+	schedule := game.NewEggSchedule(now, eggLifecycle(eggType).Apply(boosts))
+	result, err := s.db.ClaimSpawnTx(ctx, db.ClaimSpawnTxParams{
+		FinderID:    player.ID,
+		SpawnID:     spawn.ID,
+		Lat:         position.Lat,
+		Lon:         position.Lon,
+		ClaimedAt:   now,
+		PlantedAt:   schedule.PlantedAt,
+		IncubatesAt: schedule.IncubatesAt,
+		ReadyAt:     schedule.ReadyAt,
+		DecaysAt:    schedule.DecaysAt,
+		Coins:       reward.Coins,
+		Xp:          reward.XP,
+		Event:       game.Event{Kind: game.EventEggCollected, EggType: eggType.Code, At: now},
+		Check: func(locked db.GetSpawnForClaimRow) error {
+			return game.SpawnClaim{
+				Collected: locked.CollectedAt.Valid,
+				ExpiresAt: locked.ExpiresAt,
+				Distance:  locked.Distance,
+				Reach:     s.config.HatchRadiusMeters,
+				Now:       now,
+			}.Check()
+		},
+	})
+	if err != nil {
+		if handleCollectRuleError(ctx, err) {
+			return
+		}
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, HandleError(nil, http.StatusNotFound, "Spawn not found"))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to claim spawn"))
+		return
+	}
+
+	egg := result.Egg
+	ctx.JSON(http.StatusOK, ClaimSpawnResponse{
+		InventoryID: egg.InventoryID.String(),
+		Type:        egg.Type,
+		State:       egg.State,
+		ReadyAt:     egg.ReadyAt,
+		DecaysAt:    egg.DecaysAt,
+		CollectedAt: egg.CollectedAt.Time,
+		Reward:      reward,
+		Coins:       result.Player.Coins,
+		Xp:          result.Player.Xp,
+		Level:       result.Player.Level,
+		LevelUps:    levelUpResponses(result.LevelUps),
+
+		GoalUpdatesResponse: goalUpdates(result.Goals),
+	})
+}
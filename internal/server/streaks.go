@@ -0,0 +1,158 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	db "github.com/0xdbb/eggsplore/internal/database/sqlc"
+	"github.com/0xdbb/eggsplore/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Machine-readable codes of rejected daily reward claims
+const (
+	DailyRewardAlreadyClaimed = "DAILY_REWARD_ALREADY_CLAIMED"
+)
+
+// DailyRewardResponse represents a day of the rewards calendar
+type DailyRewardResponse struct {
+	Day          int32  `json:"day" example:"7"`
+	Coins        int64  `json:"coins" example:"150"`
+	Xp           int64  `json:"xp" example:"75"`
+	ShopItemCode string `json:"shop_item_code,omitempty" example:"STREAK_FREEZE"`
+	Packs        int32  `json:"packs,omitempty" example:"1"`
+}
+
+// StreakResponse represents a player's login streak and their next daily reward
+type StreakResponse struct {
+	Current      int32               `json:"current" example:"4"` // consecutive days with activity, including today
+	Longest      int32               `json:"longest" example:"12"`
+	Freezes      int32               `json:"freezes" example:"1"` // missed days the streak survives
+	ClaimedToday bool                `json:"claimed_today"`
+	NextReward   DailyRewardResponse `json:"next_reward"`
+	NextRewardAt time.Time           `json:"next_reward_at"` // now when it can be claimed, otherwise the next local midnight
+}
+
+// StreakCalendarResponse represents a player's login streak with the whole rewards calendar
+type StreakCalendarResponse struct {
+	StreakResponse
+	Calendar []DailyRewardResponse `json:"calendar"`
+}
+
+// ClaimDailyRewardResponse represents a paid daily reward
+type ClaimDailyRewardResponse struct {
+	Reward       DailyRewardResponse `json:"reward"`
+	Streak       int32               `json:"streak" example:"4"`
+	Freezes      int32               `json:"freezes" example:"1"`
+	InventoryIDs []string            `json:"inventory_ids"` // items granted
+	Coins        int64               `json:"coins"`         // player's balance after the reward
+	Xp           int64               `json:"xp"`
+	Level        int32               `json:"level"`
+	LevelUps     []LevelUpResponse   `json:"level_ups"`
+}
+
+func dailyRewardResponse(reward db.DailyRewards) DailyRewardResponse {
+	rsp := DailyRewardResponse{
+		Day:   reward.Day,
+		Coins: reward.Coins,
+		Xp:    reward.Xp,
+	}
+	if reward.ShopItemCode.Valid {
+		rsp.ShopItemCode = reward.ShopItemCode.String
+		rsp.Packs = reward.Packs
+	}
+	return rsp
+}
+
+func streakResponse(status db.StreakStatus) StreakResponse {
+	return StreakResponse{
+		Current:      status.Streak.Current,
+		Longest:      status.Streak.Longest,
+		Freezes:      status.Streak.Freezes,
+		ClaimedToday: status.ClaimedToday,
+		NextReward:   dailyRewardResponse(status.NextReward),
+		NextRewardAt: status.NextAt,
+	}
+}
+
+// @Summary		Get Login Streak
+// @Description	Get the caller's login streak and the daily rewards calendar. Every day with activity, in the time zone set in the player's settings, extends the streak; a missed day resets it unless a streak freeze covers it. The streak day picks the daily reward, repeating after day 7.
+// @Tags		game
+// @Produce		json
+// @Security	BearerAuth
+// @Success		200		{object}	StreakCalendarResponse
+// @Failure		401		{object}	ErrorResponse
+// @Failure		500		{object}	ErrorResponse
+// @Router		/game/streak [get]
+// @Router		/game/me/streak [get]
+func (s *Server) GetLoginStreak(ctx *gin.Context) {
+	player, ok := s.currentPlayer(ctx)
+	if !ok {
+		return
+	}
+
+	status, err := s.db.LoginStreak(ctx, player, util.Now())
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch streak"))
+		return
+	}
+
+	calendar := make([]DailyRewardResponse, 0, len(status.Calendar))
+	for _, reward := range status.Calendar {
+		calendar = append(calendar, dailyRewardResponse(reward))
+	}
+
+	ctx.JSON(http.StatusOK, StreakCalendarResponse{
+		StreakResponse: streakResponse(status),
+		Calendar:       calendar,
+	})
+}
+
+// @Summary		Claim Daily Reward
+// @Description	Claim the daily reward of the caller's streak day. A reward can be claimed once a day, days starting at local midnight.
+// @Tags		game
+// @Produce		json
+// @Security	BearerAuth
+// @Success		200		{object}	ClaimDailyRewardResponse
+// @Failure		401		{object}	ErrorResponse
+// @Failure		409		{object}	ErrorResponse
+// @Failure		500		{object}	ErrorResponse
+// @Router		/game/streak/claim [post]
+// @Router		/game/me/streak/claim [post]
+func (s *Server) ClaimDailyReward(ctx *gin.Context) {
+	player, ok := s.currentPlayer(ctx)
+	if !ok {
+		return
+	}
+
+	result, err := s.db.ClaimDailyRewardTx(ctx, db.ClaimDailyRewardTxParams{
+		PlayerID: player.ID,
+		Now:      util.Now(),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrAlreadyClaimed) {
+			ctx.JSON(http.StatusConflict, HandleCodedError(DailyRewardAlreadyClaimed, http.StatusConflict, "Daily reward was already claimed today"))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to claim daily reward"))
+		return
+	}
+
+	ids := make([]string, 0, len(result.InventoryIDs))
+	for _, id := range result.InventoryIDs {
+		ids = append(ids, id.String())
+	}
+
+	ctx.JSON(http.StatusOK, ClaimDailyRewardResponse{
+		Reward:       dailyRewardResponse(result.Reward),
+		Streak:       result.Streak.Current,
+		Freezes:      result.Streak.Freezes,
+		InventoryIDs: ids,
+		Coins:        result.Player.Coins,
+		Xp:           result.Player.Xp,
+		Level:        result.Player.Level,
+		LevelUps:     levelUpResponses(result.LevelUps),
+	})
+}
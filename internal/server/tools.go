@@ -0,0 +1,157 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+
+	db "github.com/0xdbb/eggsplore/internal/database/sqlc"
+	"github.com/0xdbb/eggsplore/internal/game"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ToolWearResponse reports the durability a game action consumed
+type ToolWearResponse struct {
+	InventoryID string `json:"inventory_id"`
+	Kind        string `json:"kind" example:"SHOVEL"`
+	Durability  int32  `json:"durability"` // remaining
+	Broken      bool   `json:"broken"`
+}
+
+// @Summary		Equip Tool
+// @Description	Equip one of the caller's tools. Any other tool in the same slot is unequipped.
+// @Tags		game
+// @Produce		json
+// @Security	BearerAuth
+// @Param		id	path		string	true	"Tool inventory ID"
+// @Success		200	{object}	GetToolsByPlayerResponse
+// @Failure		400	{object}	ErrorResponse
+// @Failure		401	{object}	ErrorResponse
+// @Failure		403	{object}	ErrorResponse
+// @Failure		404	{object}	ErrorResponse
+// @Failure		500	{object}	ErrorResponse
+// @Router		/game/tools/{id}/equip [post]
+// @Router		/game/me/tools/{id}/equip [post]
+func (s *Server) EquipTool(ctx *gin.Context) {
+	player, tool, ok := s.ownTool(ctx)
+	if !ok {
+		return
+	}
+
+	updated, err := s.db.EquipToolTx(ctx, player.ID, tool.InventoryID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, HandleError(nil, http.StatusNotFound, "Tool not found"))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to equip tool"))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, toolResponse(tool, updated))
+}
+
+// @Summary		Unequip Tool
+// @Description	Unequip one of the caller's tools
+// @Tags		game
+// @Produce		json
+// @Security	BearerAuth
+// @Param		id	path		string	true	"Tool inventory ID"
+// @Success		200	{object}	GetToolsByPlayerResponse
+// @Failure		400	{object}	ErrorResponse
+// @Failure		401	{object}	ErrorResponse
+// @Failure		403	{object}	ErrorResponse
+// @Failure		404	{object}	ErrorResponse
+// @Failure		500	{object}	ErrorResponse
+// @Router		/game/tools/{id}/unequip [post]
+// @Router		/game/me/tools/{id}/unequip [post]
+func (s *Server) UnequipTool(ctx *gin.Context) {
+	_, tool, ok := s.ownTool(ctx)
+	if !ok {
+		return
+	}
+
+	updated, err := s.db.SetToolEquipped(ctx, db.SetToolEquippedParams{
+		Equipped:    false,
+		InventoryID: tool.InventoryID,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, HandleError(nil, http.StatusNotFound, "Tool not found"))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to unequip tool"))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, toolResponse(tool, updated))
+}
+
+// ownTool resolves the tool in the :id path parameter, which must belong to the caller.
+func (s *Server) ownTool(ctx *gin.Context) (db.Players, db.GetToolRow, bool) {
+	toolID, ok := parseUUID(ctx, ctx.Param("id"), "tool id")
+	if !ok {
+		return db.Players{}, db.GetToolRow{}, false
+	}
+
+	player, ok := s.currentPlayer(ctx)
+	if !ok {
+		return db.Players{}, db.GetToolRow{}, false
+	}
+
+	tool, err := s.db.GetTool(ctx, toolID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, HandleError(nil, http.StatusNotFound, "Tool not found"))
+			return db.Players{}, db.GetToolRow{}, false
+		}
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch tool"))
+		return db.Players{}, db.GetToolRow{}, false
+	}
+
+	if tool.PlayerID != player.ID {
+		ctx.JSON(http.StatusForbidden, HandleError(nil, http.StatusForbidden, "Tool does not belong to you"))
+		return db.Players{}, db.GetToolRow{}, false
+	}
+	return player, tool, true
+}
+
+// equippedTool returns the player's equipped tool of the given kind as a
+// ToolUse, or nil when none is equipped.
+func (s *Server) equippedTool(ctx *gin.Context, playerID uuid.UUID, kind game.ToolKind) (*db.ToolUse, error) {
+	tool, err := s.db.GetEquippedTool(ctx, db.GetEquippedToolParams{
+		PlayerID: playerID,
+		Kind:     string(kind),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &db.ToolUse{InventoryID: tool.InventoryID, Wear: kind.Spec().Wear}, nil
+}
+
+func toolResponse(tool db.GetToolRow, updated db.Tools) GetToolsByPlayerResponse {
+	return GetToolsByPlayerResponse{
+		InventoryID: tool.InventoryID.String(),
+		Kind:        updated.Kind,
+		Slot:        updated.Slot,
+		Durability:  updated.Durability,
+		Equipped:    updated.Equipped,
+		Description: tool.Description.String,
+	}
+}
+
+func toolWearResponse(worn *db.WornTool) *ToolWearResponse {
+	if worn == nil {
+		return nil
+	}
+	return &ToolWearResponse{
+		InventoryID: worn.Tool.InventoryID.String(),
+		Kind:        worn.Tool.Kind,
+		Durability:  worn.Tool.Durability,
+		Broken:      worn.Broken,
+	}
+}
@@ -30,15 +30,22 @@ type Eggs struct {
 }
 
 type GetEggsByPlayerResponse struct {
-	InventoryID string    `json:"inventory_id"`
-	Type        string    `json:"type"`
-	Hatched     bool      `json:"hatched"`
-	Message     string    `json:"message"`
-	CollectedAt time.Time `json:"collected_at"`
+	InventoryID string     `json:"inventory_id"`
+	Type        string     `json:"type"`
+	Hatched     bool       `json:"hatched"`
+	Message     string     `json:"message"`
+	CollectedAt time.Time  `json:"collected_at"`
+	State       string     `json:"state" example:"INCUBATING"`
+	PlantedAt   time.Time  `json:"planted_at"`
+	NextState   string     `json:"next_state,omitempty" example:"READY"`
+	NextStateAt *time.Time `json:"next_state_at,omitempty"`
+	TimeLeft    int64      `json:"time_left_seconds" example:"1800"`
 }
 
 type GetToolsByPlayerResponse struct {
 	InventoryID string `json:"inventory_id"`
+	Kind        string `json:"kind" example:"RADAR"`
+	Slot        string `json:"slot" example:"DEVICE"`
 	Durability  int32  `json:"durability"`
 	Equipped    bool   `json:"equipped"`
 	Description string `json:"description"`
@@ -64,6 +71,16 @@ type Players struct {
 	UpdatedAt time.Time       `json:"updated_at"`
 }
 
+// PlayerStatsResponse represents a player with their progress in the current level and their login streak
+type PlayerStatsResponse struct {
+	Players
+	XPIntoLevel    int64          `json:"xp_into_level" example:"80"`
+	XPForNextLevel int64          `json:"xp_for_next_level" example:"183"` // XP the next level takes from the start of this one, 0 at the max level
+	XPToNextLevel  int64          `json:"xp_to_next_level" example:"103"`
+	MaxLevel       int32          `json:"max_level" example:"50"`
+	Streak         StreakResponse `json:"streak"`
+}
+
 type Session struct {
 	ID           string    `json:"id"`
 	AccountID    string    `json:"account_id"`
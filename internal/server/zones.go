@@ -0,0 +1,326 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	db "github.com/0xdbb/eggsplore/internal/database/sqlc"
+	"github.com/0xdbb/eggsplore/util"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	maxZoneHalfSize    = 5000 // meters
+	maxZonesPerImport  = 1000
+	maxZoneImportBytes = 10 << 20
+)
+
+// CreateExclusionZoneRequest describes a zone by exactly one of a bounding
+// box, a square around a center point or a GeoJSON (Multi)Polygon.
+type CreateExclusionZoneRequest struct {
+	Name           string            `json:"name" binding:"required" example:"Riverside Primary School"`
+	Description    string            `json:"description"`
+	BBox           *util.BoundingBox `json:"bbox"`
+	Center         *util.Coord       `json:"center"`
+	HalfSizeMeters float64           `json:"half_size_meters" example:"150"` // with center
+	Geometry       json.RawMessage   `json:"geometry" swaggertype:"object"`
+}
+
+// ExclusionZoneResponse represents a no-drop zone
+type ExclusionZoneResponse struct {
+	ID          string          `json:"id"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	CreatedBy   string          `json:"created_by,omitempty"`
+	CreatedAt   time.Time       `json:"created_at"`
+	Geometry    json.RawMessage `json:"geometry" swaggertype:"object"`
+}
+
+// @Summary		Create Exclusion Zone
+// @Description	Create a zone where eggs cannot be dropped or spawned. Give exactly one of bbox, center with half_size_meters, or a GeoJSON Polygon/MultiPolygon geometry.
+// @Tags		admin
+// @Accept		json
+// @Produce		json
+// @Security	BearerAuth
+// @Param		request	body		CreateExclusionZoneRequest	true	"Zone"
+// @Success		201		{object}	ExclusionZoneResponse
+// @Failure		400		{object}	ErrorResponse
+// @Failure		401		{object}	ErrorResponse
+// @Failure		403		{object}	ErrorResponse
+// @Failure		500		{object}	ErrorResponse
+// @Router		/admin/zones [post]
+func (s *Server) CreateExclusionZone(ctx *gin.Context) {
+	var req CreateExclusionZoneRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		if valErr := HandleValidationError(err); valErr != nil {
+			ctx.JSON(http.StatusBadRequest, valErr)
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, HandleError(nil, http.StatusBadRequest, "Invalid request format"))
+		return
+	}
+
+	payload, ok := authPayload(ctx)
+	if !ok {
+		return
+	}
+	createdBy := pgtype.UUID{Bytes: payload.AccountID, Valid: true}
+
+	shapes := 0
+	for _, given := range []bool{req.BBox != nil, req.Center != nil, len(req.Geometry) > 0} {
+		if given {
+			shapes++
+		}
+	}
+	if shapes != 1 {
+		ctx.JSON(http.StatusBadRequest, HandleError(nil, http.StatusBadRequest, "Provide exactly one of bbox, center or geometry"))
+		return
+	}
+
+	var (
+		zone db.ListExclusionZonesRow
+		err  error
+	)
+	switch {
+	case len(req.Geometry) > 0:
+		if err := checkZoneGeometry(req.Geometry); err != nil {
+			ctx.JSON(http.StatusBadRequest, HandleError(err, http.StatusBadRequest, "Invalid geometry"))
+			return
+		}
+		var row db.CreateExclusionZoneFromGeoJSONRow
+		row, err = s.db.CreateExclusionZoneFromGeoJSON(ctx, db.CreateExclusionZoneFromGeoJSONParams{
+			Name:        req.Name,
+			Description: req.Description,
+			Geojson:     string(req.Geometry),
+			CreatedBy:   createdBy,
+		})
+		zone = db.ListExclusionZonesRow(row)
+	default:
+		var wkt string
+		if req.BBox != nil {
+			b := *req.BBox
+			if b.XMin >= b.XMax || b.YMin >= b.YMax {
+				ctx.JSON(http.StatusBadRequest, HandleError(nil, http.StatusBadRequest, "Bounding box min must be below max"))
+				return
+			}
+			wkt = util.BBoxToWKT(b)
+		} else {
+			if req.HalfSizeMeters <= 0 || req.HalfSizeMeters > maxZoneHalfSize {
+				ctx.JSON(http.StatusBadRequest, HandleError(nil, http.StatusBadRequest, fmt.Sprintf("half_size_meters must be between 0 and %d", maxZoneHalfSize)))
+				return
+			}
+			wkt = util.PointToWKTPolygon(*req.Center, req.HalfSizeMeters)
+		}
+		var row db.CreateExclusionZoneRow
+		row, err = s.db.CreateExclusionZone(ctx, db.CreateExclusionZoneParams{
+			Name:        req.Name,
+			Description: req.Description,
+			Wkt:         wkt,
+			CreatedBy:   createdBy,
+		})
+		zone = db.ListExclusionZonesRow(row)
+	}
+	if err != nil {
+		if db.IsGeometryError(err) {
+			ctx.JSON(http.StatusBadRequest, HandleError(err, http.StatusBadRequest, "Invalid geometry"))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to create zone"))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, exclusionZoneFromRow(zone))
+}
+
+// @Summary		List Exclusion Zones
+// @Description	List all no-drop zones, newest first
+// @Tags		admin
+// @Produce		json
+// @Security	BearerAuth
+// @Success		200		{array}		ExclusionZoneResponse
+// @Failure		401		{object}	ErrorResponse
+// @Failure		403		{object}	ErrorResponse
+// @Failure		500		{object}	ErrorResponse
+// @Router		/admin/zones [get]
+func (s *Server) ListExclusionZones(ctx *gin.Context) {
+	zones, err := s.db.ListExclusionZones(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to fetch zones"))
+		return
+	}
+
+	rsp := make([]ExclusionZoneResponse, 0, len(zones))
+	for _, z := range zones {
+		rsp = append(rsp, exclusionZoneFromRow(z))
+	}
+
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+// @Summary		Delete Exclusion Zone
+// @Description	Delete a no-drop zone
+// @Tags		admin
+// @Produce		json
+// @Security	BearerAuth
+// @Param		id	path		string	true	"Zone ID"
+// @Success		200	{object}	UserMessage
+// @Failure		400	{object}	ErrorResponse
+// @Failure		401	{object}	ErrorResponse
+// @Failure		403	{object}	ErrorResponse
+// @Failure		404	{object}	ErrorResponse
+// @Failure		500	{object}	ErrorResponse
+// @Router		/admin/zones/{id} [delete]
+func (s *Server) DeleteExclusionZone(ctx *gin.Context) {
+	id, ok := parseUUID(ctx, ctx.Param("id"), "zone id")
+	if !ok {
+		return
+	}
+
+	deleted, err := s.db.DeleteExclusionZone(ctx, id)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to delete zone"))
+		return
+	}
+	if deleted == 0 {
+		ctx.JSON(http.StatusNotFound, HandleError(nil, http.StatusNotFound, "Zone not found"))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, HandleMessage("Zone deleted"))
+}
+
+// @Summary		Import Exclusion Zones
+// @Description	Import zones from a GeoJSON FeatureCollection, Feature or bare Polygon/MultiPolygon. Feature properties "name" and "description" are used when present. Either every zone is created or none.
+// @Tags		admin
+// @Accept		json
+// @Produce		json
+// @Security	BearerAuth
+// @Param		request	body		object	true	"GeoJSON"
+// @Success		201		{array}		ExclusionZoneResponse
+// @Failure		400		{object}	ErrorResponse
+// @Failure		401		{object}	ErrorResponse
+// @Failure		403		{object}	ErrorResponse
+// @Failure		500		{object}	ErrorResponse
+// @Router		/admin/zones/import [post]
+func (s *Server) ImportExclusionZones(ctx *gin.Context) {
+	body, err := io.ReadAll(io.LimitReader(ctx.Request.Body, maxZoneImportBytes))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, HandleError(err, http.StatusBadRequest, "Failed to read request body"))
+		return
+	}
+
+	payload, ok := authPayload(ctx)
+	if !ok {
+		return
+	}
+
+	zones, err := zonesFromGeoJSON(body, pgtype.UUID{Bytes: payload.AccountID, Valid: true})
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, HandleError(err, http.StatusBadRequest, "Invalid GeoJSON"))
+		return
+	}
+
+	created, err := s.db.ImportExclusionZonesTx(ctx, zones)
+	if err != nil {
+		if db.IsGeometryError(err) {
+			ctx.JSON(http.StatusBadRequest, HandleError(err, http.StatusBadRequest, "Invalid geometry"))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, HandleError(err, http.StatusInternalServerError, "Failed to import zones"))
+		return
+	}
+
+	rsp := make([]ExclusionZoneResponse, 0, len(created))
+	for _, z := range created {
+		rsp = append(rsp, exclusionZoneFromRow(db.ListExclusionZonesRow(z)))
+	}
+
+	ctx.JSON(http.StatusCreated, rsp)
+}
+
+// geoJSONObject is the subset of a GeoJSON object the zone import reads
+type geoJSONObject struct {
+	Type       string          `json:"type"`
+	Features   []geoJSONObject `json:"features"`
+	Geometry   json.RawMessage `json:"geometry"`
+	Properties struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	} `json:"properties"`
+}
+
+// zonesFromGeoJSON turns a FeatureCollection, Feature or bare geometry into
+// zones to create.
+func zonesFromGeoJSON(raw []byte, createdBy pgtype.UUID) ([]db.CreateExclusionZoneFromGeoJSONParams, error) {
+	var obj geoJSONObject
+	if err := json.Unmarshal(raw, &obj); err != nil {
+		return nil, err
+	}
+
+	var features []geoJSONObject
+	switch obj.Type {
+	case "FeatureCollection":
+		features = obj.Features
+	case "Feature":
+		features = []geoJSONObject{obj}
+	default:
+		obj.Geometry = raw
+		features = []geoJSONObject{obj}
+	}
+
+	if len(features) == 0 {
+		return nil, errors.New("no features")
+	}
+	if len(features) > maxZonesPerImport {
+		return nil, fmt.Errorf("at most %d zones per import", maxZonesPerImport)
+	}
+
+	zones := make([]db.CreateExclusionZoneFromGeoJSONParams, 0, len(features))
+	for i, f := range features {
+		if err := checkZoneGeometry(f.Geometry); err != nil {
+			return nil, fmt.Errorf("feature %d: %w", i, err)
+		}
+		zones = append(zones, db.CreateExclusionZoneFromGeoJSONParams{
+			Name:        fallback(f.Properties.Name, fmt.Sprintf("Imported zone %d", i+1)),
+			Description: f.Properties.Description,
+			Geojson:     string(f.Geometry),
+			CreatedBy:   createdBy,
+		})
+	}
+	return zones, nil
+}
+
+// checkZoneGeometry makes sure a GeoJSON geometry is an area
+func checkZoneGeometry(raw json.RawMessage) error {
+	var geom struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(raw, &geom); err != nil {
+		return err
+	}
+	if geom.Type != "Polygon" && geom.Type != "MultiPolygon" {
+		return fmt.Errorf("geometry must be a Polygon or MultiPolygon, got %q", geom.Type)
+	}
+	return nil
+}
+
+func exclusionZoneFromRow(z db.ListExclusionZonesRow) ExclusionZoneResponse {
+	rsp := ExclusionZoneResponse{
+		ID:          z.ID.String(),
+		Name:        z.Name,
+		Description: z.Description,
+		CreatedAt:   z.CreatedAt,
+		Geometry:    json.RawMessage(z.Geojson),
+	}
+	if z.CreatedBy.Valid {
+		rsp.CreatedBy = uuid.UUID(z.CreatedBy.Bytes).String()
+	}
+	return rsp
+}
@@ -0,0 +1,208 @@
+// Package spawner places server-owned eggs around recently active players
+// and configured spawn points, and removes the ones nobody collected in time.
+package spawner
+
+import (
+	"context"
+	"log"
+	"time"
+
+	db "github.com/0xdbb/eggsplore/internal/database/sqlc"
+	"github.com/0xdbb/eggsplore/internal/game"
+	"github.com/0xdbb/eggsplore/util"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// placementAttempts is how many random points are tried per area before the
+// area is skipped until the next tick
+const placementAttempts = 5
+
+// Spawner keeps the world stocked with eggs
+type Spawner struct {
+	db    *db.Service
+	rules game.SpawnRules
+}
+
+// New returns a spawner placing eggs by rules
+func New(service *db.Service, rules game.SpawnRules) *Spawner {
+	return &Spawner{db: service, rules: rules}
+}
+
+// TickResult is what a tick changed
+type TickResult struct {
+	Expired int64
+	Spawned int
+}
+
+// area is a circle the spawner keeps up to maxActive eggs in
+type area struct {
+	center       util.Coord
+	radius       float64
+	maxActive    int32
+	spawnPointID pgtype.Int4 // unset for areas around players
+}
+
+// Run ticks every interval until ctx is done. Failed ticks are logged and
+// retried on the next one.
+func (s *Spawner) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		result, err := s.Tick(ctx, util.Now())
+		switch {
+		case err != nil && ctx.Err() == nil:
+			log.Printf("⚠️ Spawner tick failed: %v", err)
+		case result.Expired > 0 || result.Spawned > 0:
+			log.Printf("Spawner: %d spawned, %d expired", result.Spawned, result.Expired)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Tick removes expired spawns and places at most one egg in every area below
+// its density cap. Areas fill up over several ticks, so spawns appear
+// gradually instead of all at once.
+func (s *Spawner) Tick(ctx context.Context, now time.Time) (TickResult, error) {
+	var result TickResult
+	var err error
+
+	result.Expired, err = s.db.DeleteExpiredSpawns(ctx, now)
+	if err != nil {
+		return result, err
+	}
+
+	eggTypes, err := s.db.ListSpawnableEggTypes(ctx)
+	if err != nil || len(eggTypes) == 0 {
+		return result, err
+	}
+	weights := make([]int32, len(eggTypes))
+	for i, t := range eggTypes {
+		weights[i] = t.SpawnWeight
+	}
+
+	areas, err := s.areas(ctx, now)
+	if err != nil {
+		return result, err
+	}
+
+	for _, a := range areas {
+		eggType := eggTypes[game.WeightedIndex(weights)].Code
+		spawned, err := s.fill(ctx, a, eggType, now)
+		if err != nil {
+			return result, err
+		}
+		if spawned {
+			result.Spawned++
+		}
+	}
+	return result, nil
+}
+
+// areas returns the areas to keep stocked: every enabled spawn point and the
+// surroundings of every recently active player
+func (s *Spawner) areas(ctx context.Context, now time.Time) ([]area, error) {
+	points, err := s.db.ListSpawnPoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+	players, err := s.db.ListActivePlayerPositions(ctx, now.Add(-s.rules.ActiveWindow))
+	if err != nil {
+		return nil, err
+	}
+
+	areas := make([]area, 0, len(points)+len(players))
+	for _, p := range points {
+		areas = append(areas, area{
+			center:       util.Coord{Lat: p.Lat, Lon: p.Lon},
+			radius:       p.RadiusMeters,
+			maxActive:    p.MaxActive,
+			spawnPointID: pgtype.Int4{Int32: p.ID, Valid: true},
+		})
+	}
+	if s.rules.PlayerMaxActive > 0 {
+		for _, p := range players {
+			areas = append(areas, area{
+				center:    util.Coord{Lat: p.Lat, Lon: p.Lon},
+				radius:    s.rules.PlayerRadius,
+				maxActive: s.rules.PlayerMaxActive,
+			})
+		}
+	}
+	return areas, nil
+}
+
+// fill places an egg of eggType in a if it is below its density cap. Points
+// inside exclusion zones or too close to another egg are never used.
+func (s *Spawner) fill(ctx context.Context, a area, eggType string, now time.Time) (bool, error) {
+	active, err := s.db.CountSpawnsWithin(ctx, db.CountSpawnsWithinParams{
+		Now:    now,
+		Lon:    a.center.Lon,
+		Lat:    a.center.Lat,
+		Radius: a.radius,
+	})
+	if err != nil || active >= a.maxActive {
+		return false, err
+	}
+
+	for range placementAttempts {
+		point := util.RandomCoordWithin(a.center, a.radius)
+
+		ok, err := s.placeable(ctx, point, now)
+		if err != nil {
+			return false, err
+		}
+		if !ok {
+			continue
+		}
+
+		_, err = s.db.CreateSpawn(ctx, db.CreateSpawnParams{
+			EggType:      eggType,
+			Lon:          point.Lon,
+			Lat:          point.Lat,
+			SpawnPointID: a.spawnPointID,
+			SpawnedAt:    now,
+			ExpiresAt:    now.Add(s.rules.TTL),
+		})
+		return err == nil, err
+	}
+	return false, nil
+}
+
+// placeable reports whether an egg may be spawned at point
+func (s *Spawner) placeable(ctx context.Context, point util.Coord, now time.Time) (bool, error) {
+	excluded, err := s.db.IsPointExcluded(ctx, db.IsPointExcludedParams{
+		Lon: point.Lon,
+		Lat: point.Lat,
+	})
+	if err != nil || excluded {
+		return false, err
+	}
+
+	if s.rules.MinSpacingMeters <= 0 {
+		return true, nil
+	}
+
+	tooClose, err := s.db.HasEggWithin(ctx, db.HasEggWithinParams{
+		Lon:      point.Lon,
+		Lat:      point.Lat,
+		Distance: s.rules.MinSpacingMeters,
+	})
+	if err != nil || tooClose {
+		return false, err
+	}
+
+	tooClose, err = s.db.HasSpawnWithin(ctx, db.HasSpawnWithinParams{
+		Now:      now,
+		Lon:      point.Lon,
+		Lat:      point.Lat,
+		Distance: s.rules.MinSpacingMeters,
+	})
+	return !tooClose, err
+}
@@ -3,6 +3,7 @@ package util
 import (
 	"fmt"
 	"math"
+	"math/rand/v2"
 )
 
 type Coord struct {
@@ -58,3 +59,48 @@ func BBoxToWKT(bbox BoundingBox) string {
 		minLon, minLat, // closing the ring
 	)
 }
+
+// Offset returns the coordinate meters away from coord towards bearing, in
+// degrees clockwise from north. The earth is treated as flat around coord,
+// which is close enough over the few kilometers game features span.
+func Offset(coord Coord, meters, bearing float64) Coord {
+	const earthRadius = 6378137.0
+
+	theta := bearing * math.Pi / 180
+	dLat := meters * math.Cos(theta) / earthRadius
+	dLon := meters * math.Sin(theta) / (earthRadius * math.Cos(math.Pi*coord.Lat/180))
+
+	return Coord{
+		Lat: coord.Lat + dLat*(180/math.Pi),
+		Lon: coord.Lon + dLon*(180/math.Pi),
+	}
+}
+
+// RandomCoordWithin returns a coordinate picked uniformly at random within
+// radius meters of center
+func RandomCoordWithin(center Coord, radius float64) Coord {
+	return Offset(center, radius*math.Sqrt(rand.Float64()), rand.Float64()*360)
+}
+
+// Distance returns the great-circle distance in meters between a and b.
+func Distance(a, b Coord) float64 {
+	const earthRadius = 6378137.0
+
+	lat1, lat2 := a.Lat*math.Pi/180, b.Lat*math.Pi/180
+	dLat := lat2 - lat1
+	dLon := (b.Lon - a.Lon) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// Reach returns the distance in meters from coord to the farthest corner of
+// the box, the radius around coord that covers all of it.
+func (b BoundingBox) Reach(coord Coord) float64 {
+	return max(
+		Distance(coord, Coord{Lat: b.YMin, Lon: b.XMin}),
+		Distance(coord, Coord{Lat: b.YMin, Lon: b.XMax}),
+		Distance(coord, Coord{Lat: b.YMax, Lon: b.XMin}),
+		Distance(coord, Coord{Lat: b.YMax, Lon: b.XMax}),
+	)
+}
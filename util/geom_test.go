@@ -0,0 +1,56 @@
+package util
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Coord
+		want float64 // meters
+	}{
+		{"same point", Coord{Lat: 5.6, Lon: -0.18}, Coord{Lat: 5.6, Lon: -0.18}, 0},
+		{"one degree of latitude", Coord{Lat: 0, Lon: 0}, Coord{Lat: 1, Lon: 0}, 111319},
+		{"one degree of longitude at the equator", Coord{Lat: 0, Lon: 0}, Coord{Lat: 0, Lon: 1}, 111319},
+		{"one degree of longitude at 60 degrees", Coord{Lat: 60, Lon: 0}, Coord{Lat: 60, Lon: 1}, 55660},
+		{"across the antimeridian", Coord{Lat: 0, Lon: 179.5}, Coord{Lat: 0, Lon: -179.5}, 111319},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Distance(tt.a, tt.b); math.Abs(got-tt.want) > 1 {
+				t.Errorf("Distance() = %.0f, want %.0f", got, tt.want)
+			}
+			if got, back := Distance(tt.a, tt.b), Distance(tt.b, tt.a); math.Abs(got-back) > 1e-6 {
+				t.Errorf("Distance() is not symmetric: %f and %f", got, back)
+			}
+		})
+	}
+}
+
+func TestBoundingBoxReach(t *testing.T) {
+	center := Coord{Lat: 0, Lon: 0}
+	box := BoundingBox{XMin: -0.01, YMin: -0.01, XMax: 0.01, YMax: 0.01}
+	corner := Distance(center, Coord{Lat: 0.01, Lon: 0.01})
+
+	tests := []struct {
+		name  string
+		box   BoundingBox
+		coord Coord
+		want  float64
+	}{
+		{"centered", box, center, corner},
+		{"on a corner", box, Coord{Lat: -0.01, Lon: -0.01}, Distance(Coord{Lat: -0.01, Lon: -0.01}, Coord{Lat: 0.01, Lon: 0.01})},
+		{"far away", box, Coord{Lat: 0, Lon: 1}, Distance(Coord{Lat: 0, Lon: 1}, Coord{Lat: 0.01, Lon: -0.01})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.box.Reach(tt.coord); math.Abs(got-tt.want) > 1e-6 {
+				t.Errorf("Reach() = %.1f, want %.1f", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package util
+
+import (
+	"fmt"
+	"math"
+)
+
+// maxMercatorLat is the latitude web map tiles stop at
+const maxMercatorLat = 85.05112878
+
+// Tile is a square of the web map grid at a zoom level, numbered like slippy
+// map tiles: x grows east from the antimeridian, y grows south from the top.
+type Tile struct {
+	Z int `json:"z" example:"15"`
+	X int `json:"x" example:"16201"`
+	Y int `json:"y" example:"15834"`
+}
+
+// TileAt returns the tile at zoom containing coord
+func TileAt(coord Coord, zoom int) Tile {
+	n := math.Exp2(float64(zoom))
+	lat := math.Max(-maxMercatorLat, math.Min(maxMercatorLat, coord.Lat)) * math.Pi / 180
+
+	x := int(math.Floor((coord.Lon + 180) / 360 * n))
+	y := int(math.Floor((1 - math.Log(math.Tan(lat)+1/math.Cos(lat))/math.Pi) / 2 * n))
+
+	last := int(n) - 1
+	return Tile{Z: zoom, X: max(0, min(x, last)), Y: max(0, min(y, last))}
+}
+
+// Valid reports whether the tile exists at its zoom level
+func (t Tile) Valid() bool {
+	if t.Z < 0 {
+		return false
+	}
+	n := 1 << t.Z
+	return t.X >= 0 && t.X < n && t.Y >= 0 && t.Y < n
+}
+
+// Bounds returns the area the tile covers
+func (t Tile) Bounds() BoundingBox {
+	n := math.Exp2(float64(t.Z))
+	lon := func(x int) float64 {
+		return float64(x)/n*360 - 180
+	}
+	lat := func(y int) float64 {
+		return math.Atan(math.Sinh(math.Pi*(1-2*float64(y)/n))) * 180 / math.Pi
+	}
+
+	return BoundingBox{XMin: lon(t.X), YMin: lat(t.Y + 1), XMax: lon(t.X + 1), YMax: lat(t.Y)}
+}
+
+func (t Tile) String() string {
+	return fmt.Sprintf("%d/%d/%d", t.Z, t.X, t.Y)
+}
+
+// TilesCovering returns the tiles at zoom overlapping bbox. It gives up and
+// returns false when that would take more than limit tiles.
+func TilesCovering(bbox BoundingBox, zoom, limit int) ([]Tile, bool) {
+	topLeft := TileAt(Coord{Lat: bbox.YMax, Lon: bbox.XMin}, zoom)
+	bottomRight := TileAt(Coord{Lat: bbox.YMin, Lon: bbox.XMax}, zoom)
+
+	cols := bottomRight.X - topLeft.X + 1
+	rows := bottomRight.Y - topLeft.Y + 1
+	if cols <= 0 || rows <= 0 || cols*rows > limit {
+		return nil, false
+	}
+
+	tiles := make([]Tile, 0, cols*rows)
+	for y := topLeft.Y; y <= bottomRight.Y; y++ {
+		for x := topLeft.X; x <= bottomRight.X; x++ {
+			tiles = append(tiles, Tile{Z: zoom, X: x, Y: y})
+		}
+	}
+	return tiles, true
+}
@@ -0,0 +1,129 @@
+package util
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestTileAt(t *testing.T) {
+	tests := []struct {
+		name  string
+		coord Coord
+		zoom  int
+		want  Tile
+	}{
+		{"whole world", Coord{Lat: 10, Lon: 10}, 0, Tile{Z: 0, X: 0, Y: 0}},
+		{"origin is the south east quarter", Coord{Lat: 0, Lon: 0}, 1, Tile{Z: 1, X: 1, Y: 1}},
+		{"berlin", Coord{Lat: 52.52, Lon: 13.405}, 10, Tile{Z: 10, X: 550, Y: 335}},
+		{"accra", Coord{Lat: 5.6037, Lon: -0.187}, 15, Tile{Z: 15, X: 16366, Y: 15873}},
+		{"sydney", Coord{Lat: -33.8688, Lon: 151.2093}, 12, Tile{Z: 12, X: 3768, Y: 2457}},
+		{"north of the map is clamped", Coord{Lat: 89, Lon: -180}, 2, Tile{Z: 2, X: 0, Y: 0}},
+		{"south east corner is clamped", Coord{Lat: -90, Lon: 180}, 2, Tile{Z: 2, X: 3, Y: 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TileAt(tt.coord, tt.zoom); got != tt.want {
+				t.Errorf("TileAt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTileValid(t *testing.T) {
+	tests := []struct {
+		tile Tile
+		want bool
+	}{
+		{Tile{Z: 0, X: 0, Y: 0}, true},
+		{Tile{Z: 2, X: 3, Y: 3}, true},
+		{Tile{Z: 2, X: 4, Y: 0}, false},
+		{Tile{Z: 2, X: 0, Y: 4}, false},
+		{Tile{Z: 2, X: -1, Y: 0}, false},
+		{Tile{Z: -1, X: 0, Y: 0}, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.tile.Valid(); got != tt.want {
+			t.Errorf("%v.Valid() = %v, want %v", tt.tile, got, tt.want)
+		}
+	}
+}
+
+func TestTileBounds(t *testing.T) {
+	tests := []struct {
+		tile Tile
+		want BoundingBox
+	}{
+		{Tile{Z: 0, X: 0, Y: 0}, BoundingBox{XMin: -180, YMin: -maxMercatorLat, XMax: 180, YMax: maxMercatorLat}},
+		{Tile{Z: 1, X: 0, Y: 0}, BoundingBox{XMin: -180, YMin: 0, XMax: 0, YMax: maxMercatorLat}},
+		{Tile{Z: 1, X: 1, Y: 1}, BoundingBox{XMin: 0, YMin: -maxMercatorLat, XMax: 180, YMax: 0}},
+	}
+
+	near := func(a, b float64) bool { return math.Abs(a-b) < 1e-6 }
+	for _, tt := range tests {
+		got := tt.tile.Bounds()
+		if !near(got.XMin, tt.want.XMin) || !near(got.YMin, tt.want.YMin) || !near(got.XMax, tt.want.XMax) || !near(got.YMax, tt.want.YMax) {
+			t.Errorf("%v.Bounds() = %+v, want %+v", tt.tile, got, tt.want)
+		}
+
+		// The middle of a tile lies in the tile
+		center := Coord{Lat: (got.YMin + got.YMax) / 2, Lon: (got.XMin + got.XMax) / 2}
+		if back := TileAt(center, tt.tile.Z); back != tt.tile {
+			t.Errorf("TileAt(center of %v) = %v", tt.tile, back)
+		}
+	}
+}
+
+func TestTilesCovering(t *testing.T) {
+	world := BoundingBox{XMin: -180, YMin: -85, XMax: 180, YMax: 85}
+
+	tests := []struct {
+		name   string
+		bbox   BoundingBox
+		zoom   int
+		limit  int
+		want   []Tile
+		wantOK bool
+	}{
+		{
+			name: "inside one tile",
+			bbox: BoundingBox{XMin: 10, YMin: 10, XMax: 20, YMax: 20},
+			zoom: 1, limit: 4,
+			want:   []Tile{{Z: 1, X: 1, Y: 0}},
+			wantOK: true,
+		},
+		{
+			name: "whole world, row by row",
+			bbox: world,
+			zoom: 1, limit: 4,
+			want:   []Tile{{Z: 1, X: 0, Y: 0}, {Z: 1, X: 1, Y: 0}, {Z: 1, X: 0, Y: 1}, {Z: 1, X: 1, Y: 1}},
+			wantOK: true,
+		},
+		{
+			name: "across the equator",
+			bbox: BoundingBox{XMin: 10, YMin: -10, XMax: 20, YMax: 10},
+			zoom: 1, limit: 4,
+			want:   []Tile{{Z: 1, X: 1, Y: 0}, {Z: 1, X: 1, Y: 1}},
+			wantOK: true,
+		},
+		{
+			name: "more tiles than the limit",
+			bbox: world,
+			zoom: 2, limit: 15,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := TilesCovering(tt.bbox, tt.zoom, tt.limit)
+			if ok != tt.wantOK {
+				t.Fatalf("TilesCovering() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("TilesCovering() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}